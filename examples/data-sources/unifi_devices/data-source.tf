@@ -0,0 +1,18 @@
+data "unifi_devices" "us_24_poe" {
+  type  = "usw"
+  model = "US24P250"
+}
+
+resource "unifi_device" "us_24_poe" {
+  for_each = { for d in data.unifi_devices.us_24_poe.devices : d.mac => d }
+
+  mac  = each.key
+  name = each.value.name
+
+  # use the same uplink port settings on all of these switches
+  port_override {
+    number          = 24
+    name            = "uplink"
+    port_profile_id = var.uplink_port_profile_id
+  }
+}
@@ -0,0 +1,9 @@
+data "unifi_users" "iot" {
+  note_regex = "(?i)iot"
+}
+
+resource "unifi_firewall_group" "iot" {
+  name    = "iot"
+  type    = "address-group"
+  members = [for u in data.unifi_users.iot.users : u.fixed_ip if u.fixed_ip != ""]
+}
@@ -0,0 +1,2 @@
+data "unifi_wlan_group" "default" {
+}
\ No newline at end of file
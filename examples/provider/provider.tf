@@ -3,6 +3,9 @@ provider "unifi" {
   password = var.password # optionally use UNIFI_PASSWORD env var
   api_url  = var.api_url  # optionally use UNIFI_API env var
 
+  # on UniFi OS consoles you can authenticate with an API key instead
+  # api_key = var.api_key # optionally use UNIFI_API_KEY env var
+
   # you may need to allow insecure TLS communications unless you have configured
   # certificates for your controller
   allow_insecure = var.insecure # optionally use UNIFI_INSECURE env var
@@ -0,0 +1,8 @@
+resource "unifi_ap_group" "lobby" {
+  name = "lobby"
+
+  device_macs = [
+    "00:00:5e:00:53:01",
+    "00:00:5e:00:53:02",
+  ]
+}
@@ -34,6 +34,33 @@ resource "unifi_device" "us_24_poe" {
     port_profile_id = data.unifi_port_profile.disabled.id
   }
 
+  port_override {
+    number  = 3
+    name    = "camera"
+    forward = "customize"
+
+    native_network_id  = var.native_network_id
+    tagged_network_ids = [var.some_vlan_network_id]
+
+    isolation                 = true
+    port_security_enabled     = true
+    port_security_mac_address = ["01:23:45:67:89:02"]
+
+    egress_rate_limit_kbps_enabled = true
+    egress_rate_limit_kbps         = 10000
+
+    stormctrl_type          = "level"
+    stormctrl_bcast_enabled = true
+    stormctrl_bcast_level   = 10
+  }
+
+  # mirror the traffic of port 1 to port 10
+  port_override {
+    number          = 10
+    op_mode         = "mirror"
+    mirror_port_idx = 1
+  }
+
   # port aggregation for ports 11 and 12
   port_override {
     number              = 11
@@ -41,3 +68,60 @@ resource "unifi_device" "us_24_poe" {
     aggregate_num_ports = 2
   }
 }
+
+resource "unifi_device" "lobby_ap" {
+  mac  = "01:23:45:67:89:CD"
+  name = "Lobby AP"
+
+  radio {
+    band          = "ng"
+    channel       = "1"
+    channel_width = 20
+    tx_power_mode = "low"
+  }
+
+  radio {
+    band          = "na"
+    channel       = "auto"
+    channel_width = 40
+    tx_power_mode = "custom"
+    tx_power      = 14
+    min_rssi      = -75
+  }
+}
+
+resource "unifi_device" "core_switch" {
+  mac  = "01:23:45:67:89:EF"
+  name = "Core Switch"
+
+  # make this switch the spanning tree root bridge
+  stp_version  = "rstp"
+  stp_priority = 4096
+
+  snmp_location = "Server room, rack 1"
+
+  management {
+    type       = "static"
+    ip         = "10.0.10.2"
+    netmask    = "255.255.255.0"
+    gateway    = "10.0.10.1"
+    dns1       = "10.0.10.1"
+    network_id = var.management_network_id
+  }
+}
+
+resource "unifi_device" "rack_pdu" {
+  mac  = "01:23:45:67:89:01"
+  name = "Rack PDU"
+
+  outlet_override {
+    index = 1
+    name  = "Core Switch"
+  }
+
+  outlet_override {
+    index       = 8
+    name        = "Spare"
+    relay_state = false
+  }
+}
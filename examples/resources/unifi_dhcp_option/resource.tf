@@ -0,0 +1,17 @@
+resource "unifi_dhcp_option" "tftp_servers" {
+  name = "tftp-servers"
+  code = 150
+  type = "ipaddress"
+}
+
+resource "unifi_network" "voice" {
+  name    = "Voice"
+  purpose = "corporate"
+  subnet  = "10.0.20.1/24"
+  vlan_id = 20
+
+  dhcp_option {
+    option_id = unifi_dhcp_option.tftp_servers.id
+    value     = "10.0.20.10,10.0.20.11"
+  }
+}
@@ -0,0 +1,13 @@
+resource "unifi_dpi_app" "streaming" {
+  name         = "Block streaming"
+  blocked      = true
+  category_ids = [4]
+}
+
+resource "unifi_dpi_app" "games" {
+  name         = "Limit games"
+  category_ids = [8]
+
+  qos_rate_max_down = 2048
+  qos_rate_max_up   = 512
+}
@@ -0,0 +1,20 @@
+resource "unifi_dpi_app" "streaming" {
+  name         = "Block streaming"
+  blocked      = true
+  category_ids = [4]
+}
+
+resource "unifi_dpi_group" "school" {
+  name        = "School"
+  dpi_app_ids = [unifi_dpi_app.streaming.id]
+}
+
+resource "unifi_wlan" "school" {
+  name          = "School"
+  security      = "wpapsk"
+  passphrase    = "12345678"
+  user_group_id = data.unifi_user_group.default.id
+  dpi_group_id  = unifi_dpi_group.school.id
+}
+
+data "unifi_user_group" "default" {}
@@ -0,0 +1,5 @@
+# import from provider configured site using the ruleset name
+terraform import unifi_firewall_rule_order.lan_in LAN_IN
+
+# import from another site
+terraform import unifi_firewall_rule_order.lan_in bfa2l6i7:LAN_IN
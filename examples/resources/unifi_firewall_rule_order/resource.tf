@@ -0,0 +1,27 @@
+resource "unifi_firewall_rule" "allow_dns" {
+  name    = "allow dns"
+  action  = "accept"
+  ruleset = "LAN_IN"
+
+  protocol    = "udp"
+  dst_address = "192.168.1.1"
+  dst_port    = 53
+}
+
+resource "unifi_firewall_rule" "drop_all" {
+  name    = "drop all"
+  action  = "drop"
+  ruleset = "LAN_IN"
+
+  protocol    = "all"
+  dst_address = "192.168.1.1"
+}
+
+resource "unifi_firewall_rule_order" "lan_in" {
+  ruleset = "LAN_IN"
+
+  rule_ids = [
+    unifi_firewall_rule.allow_dns.id,
+    unifi_firewall_rule.drop_all.id,
+  ]
+}
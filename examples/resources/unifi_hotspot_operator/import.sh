@@ -0,0 +1,5 @@
+# import from provider configured site
+terraform import unifi_hotspot_operator.front_desk 5fe6261995fe130013456a36
+
+# import from another site
+terraform import unifi_hotspot_operator.front_desk bfa2l6i7:5fe6261995fe130013456a36
@@ -0,0 +1,10 @@
+variable "front_desk_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "unifi_hotspot_operator" "front_desk" {
+  name     = "front-desk"
+  note     = "Prints vouchers for hotel guests"
+  password = var.front_desk_password
+}
@@ -0,0 +1,15 @@
+resource "unifi_hotspot_package" "day_pass" {
+  name     = "Day pass"
+  amount   = 4.99
+  currency = "USD"
+  hours    = 24
+
+  down_limit_kbps = 20000
+  up_limit_kbps   = 5000
+
+  payment_fields {
+    email      = "required"
+    first_name = "optional"
+    last_name  = "optional"
+  }
+}
@@ -0,0 +1,16 @@
+variable "access_point_macs" {
+  type = list(string)
+}
+
+# upgrade all access points on Sunday nights
+resource "unifi_schedule_task" "ap_upgrades" {
+  name      = "Access point upgrades"
+  cron_expr = "0 3 * * 0"
+
+  dynamic "upgrade_target" {
+    for_each = var.access_point_macs
+    content {
+      mac = upgrade_target.value
+    }
+  }
+}
@@ -0,0 +1,3 @@
+resource "unifi_setting_auto_speedtest" "site" {
+  cron_expr = "0 */6 * * *"
+}
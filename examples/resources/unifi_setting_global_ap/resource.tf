@@ -0,0 +1,8 @@
+resource "unifi_setting_global_ap" "site" {
+  channel_size_2g  = 20
+  tx_power_mode_2g = "low"
+
+  channel_size_5g  = 80
+  tx_power_mode_5g = "custom"
+  tx_power_5g      = 17
+}
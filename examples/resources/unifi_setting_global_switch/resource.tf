@@ -0,0 +1,29 @@
+resource "unifi_network" "quarantine" {
+  name    = "Quarantine"
+  purpose = "corporate"
+  subnet  = "10.0.99.1/24"
+  vlan_id = 99
+}
+
+resource "unifi_radius_profile" "dot1x" {
+  name = "802.1X"
+
+  auth_server {
+    ip      = "10.0.0.5"
+    xsecret = var.radius_secret
+  }
+}
+
+resource "unifi_setting_global_switch" "site" {
+  stp_version = "rstp"
+  dhcp_snoop  = true
+
+  dot1x_portctrl_enabled    = true
+  radius_profile_id         = unifi_radius_profile.dot1x.id
+  dot1x_fallback_network_id = unifi_network.quarantine.id
+}
+
+variable "radius_secret" {
+  type      = string
+  sensitive = true
+}
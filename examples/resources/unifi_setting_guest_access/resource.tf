@@ -0,0 +1,31 @@
+variable "guest_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "unifi_setting_guest_access" "portal" {
+  auth           = "hotspot"
+  portal_enabled = true
+
+  expire = "480"
+
+  password_enabled = true
+  password         = var.guest_password
+
+  branding {
+    title        = "Welcome to the store"
+    welcome_text = "Enjoy free Wi-Fi while you shop."
+    tos          = "Use of this network is subject to our acceptable use policy."
+    languages    = ["en", "fr"]
+    bg_color     = "#1a1a1a"
+  }
+
+  redirect {
+    url = "https://example.com/welcome"
+  }
+
+  restrictions {
+    restricted_subnet = "192.168.0.0/16"
+    dns_servers       = ["1.1.1.1", "1.0.0.1"]
+  }
+}
@@ -0,0 +1,36 @@
+resource "unifi_network" "school" {
+  name    = "School"
+  purpose = "corporate"
+  subnet  = "10.0.10.1/24"
+  vlan_id = 10
+}
+
+resource "unifi_setting_ips" "site" {
+  ips_mode           = "ips"
+  enabled_categories = ["botcc", "emerging-malware", "emerging-exploit", "tor"]
+  restrict_tor       = true
+  restrict_torrents  = true
+
+  ad_blocking {
+    network_ids = [unifi_network.school.id]
+  }
+
+  dns_filter {
+    network_id    = unifi_network.school.id
+    filter        = "family"
+    blocked_sites = ["example.com"]
+  }
+
+  honeypot {
+    network_id = unifi_network.school.id
+    ip_address = "10.0.10.2"
+  }
+
+  suppression {
+    whitelist {
+      direction = "src"
+      mode      = "ip"
+      value     = "10.0.10.10"
+    }
+  }
+}
@@ -0,0 +1,6 @@
+resource "unifi_setting_ntp" "example" {
+  ntp_servers = [
+    "time.cloudflare.com",
+    "pool.ntp.org",
+  ]
+}
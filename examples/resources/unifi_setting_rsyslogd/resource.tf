@@ -0,0 +1,5 @@
+resource "unifi_setting_rsyslogd" "example" {
+  enabled = true
+  ip      = "10.0.0.50"
+  port    = 514
+}
@@ -0,0 +1,10 @@
+variable "snmp_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "unifi_setting_snmp" "example" {
+  v3_enabled = true
+  username   = "monitoring"
+  password   = var.snmp_password
+}
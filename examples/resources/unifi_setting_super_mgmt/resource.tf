@@ -0,0 +1,31 @@
+resource "unifi_setting_super_mgmt" "controller" {
+  autobackup_enabled      = true
+  autobackup_cron_expr    = "0 2 * * *"
+  autobackup_timezone     = "UTC"
+  autobackup_max_files    = 14
+  autobackup_post_actions = ["copy_s3"]
+
+  autobackup_s3_bucket        = "unifi-backups"
+  autobackup_s3_access_key    = var.backup_access_key
+  autobackup_s3_access_secret = var.backup_access_secret
+
+  override_inform_host          = true
+  override_inform_host_location = "unifi.example.com"
+
+  ssh_username = "admin"
+  ssh_password = var.device_ssh_password
+}
+
+variable "backup_access_key" {
+  type = string
+}
+
+variable "backup_access_secret" {
+  type      = string
+  sensitive = true
+}
+
+variable "device_ssh_password" {
+  type      = string
+  sensitive = true
+}
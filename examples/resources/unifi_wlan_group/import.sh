@@ -0,0 +1,2 @@
+# import using the ID
+terraform import unifi_wlan_group.guest 5fe6261995fe130013456a36
@@ -0,0 +1,3 @@
+resource "unifi_wlan_group" "guest" {
+  name = "guest"
+}
@@ -7,6 +7,10 @@ toolchain go1.24.1
 // replace github.com/hashicorp/terraform-plugin-docs => ../../hashicorp/terraform-plugin-docs
 // replace github.com/hashicorp/terraform-plugin-sdk/v2 => ../../hashicorp/terraform-plugin-sdk
 
+// go-unifi v1.32.0 plus the client additions the provider needs that are not released
+// yet, drop this and bump go-unifi once they are
+replace github.com/paultyng/go-unifi => ./third_party/go-unifi
+
 require (
 	github.com/apparentlymart/go-cidr v1.1.0
 	github.com/deckarep/golang-set/v2 v2.7.0
@@ -0,0 +1,422 @@
+package provider
+
+import (
+	"context"
+	"sync"
+
+	"github.com/paultyng/go-unifi/unifi"
+)
+
+// cachingClient memoises List results per object type and site for the life of the
+// provider process (a single plan, refresh or apply), and serves Get requests for the
+// same types from those lists. Any create, update or delete of a type invalidates its
+// cached lists for the site.
+//
+// Devices are intentionally not cached, their state is polled while waiting for
+// provisioning to finish.
+type cachingClient struct {
+	unifiClient
+
+	mu    sync.Mutex
+	lists map[listCacheKey]*listCacheEntry
+}
+
+type listCacheKey struct {
+	kind string
+	site string
+}
+
+type listCacheEntry struct {
+	done  chan struct{}
+	value interface{}
+	err   error
+}
+
+func newCachingClient(c unifiClient) *cachingClient {
+	return &cachingClient{
+		unifiClient: c,
+		lists:       map[listCacheKey]*listCacheEntry{},
+	}
+}
+
+func (c *cachingClient) invalidate(kind, site string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.lists, listCacheKey{kind, site})
+}
+
+// cachedList returns the cached list for the kind and site, concurrent callers for the
+// same list share a single request. Errors are returned to the waiting callers but not cached.
+func cachedList[T any](ctx context.Context, c *cachingClient, kind, site string, list func(context.Context, string) ([]T, error)) ([]T, error) {
+	key := listCacheKey{kind, site}
+
+	c.mu.Lock()
+	e, ok := c.lists[key]
+	if !ok {
+		e = &listCacheEntry{done: make(chan struct{})}
+		c.lists[key] = e
+	}
+	c.mu.Unlock()
+
+	if ok {
+		select {
+		case <-e.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	} else {
+		e.value, e.err = list(ctx, site)
+		close(e.done)
+
+		if e.err != nil {
+			c.mu.Lock()
+			if c.lists[key] == e {
+				delete(c.lists, key)
+			}
+			c.mu.Unlock()
+		}
+	}
+
+	if e.err != nil {
+		return nil, e.err
+	}
+
+	// copy so callers can't modify the cached values
+	return append([]T(nil), e.value.([]T)...), nil
+}
+
+func cachedGet[T any](ctx context.Context, c *cachingClient, kind, site, id string, list func(context.Context, string) ([]T, error), idOf func(T) string) (*T, error) {
+	values, err := cachedList(ctx, c, kind, site, list)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range values {
+		if idOf(values[i]) == id {
+			return &values[i], nil
+		}
+	}
+
+	return nil, &unifi.NotFoundError{}
+}
+
+func (c *cachingClient) ListUserGroup(ctx context.Context, site string) ([]unifi.UserGroup, error) {
+	return cachedList(ctx, c, "user_group", site, c.unifiClient.ListUserGroup)
+}
+
+func (c *cachingClient) GetUserGroup(ctx context.Context, site, id string) (*unifi.UserGroup, error) {
+	return cachedGet(ctx, c, "user_group", site, id, c.unifiClient.ListUserGroup, func(v unifi.UserGroup) string { return v.ID })
+}
+
+func (c *cachingClient) CreateUserGroup(ctx context.Context, site string, d *unifi.UserGroup) (*unifi.UserGroup, error) {
+	defer c.invalidate("user_group", site)
+	return c.unifiClient.CreateUserGroup(ctx, site, d)
+}
+
+func (c *cachingClient) UpdateUserGroup(ctx context.Context, site string, d *unifi.UserGroup) (*unifi.UserGroup, error) {
+	defer c.invalidate("user_group", site)
+	return c.unifiClient.UpdateUserGroup(ctx, site, d)
+}
+
+func (c *cachingClient) DeleteUserGroup(ctx context.Context, site, id string) error {
+	defer c.invalidate("user_group", site)
+	return c.unifiClient.DeleteUserGroup(ctx, site, id)
+}
+
+func (c *cachingClient) ListFirewallGroup(ctx context.Context, site string) ([]unifi.FirewallGroup, error) {
+	return cachedList(ctx, c, "firewall_group", site, c.unifiClient.ListFirewallGroup)
+}
+
+func (c *cachingClient) GetFirewallGroup(ctx context.Context, site, id string) (*unifi.FirewallGroup, error) {
+	return cachedGet(ctx, c, "firewall_group", site, id, c.unifiClient.ListFirewallGroup, func(v unifi.FirewallGroup) string { return v.ID })
+}
+
+func (c *cachingClient) CreateFirewallGroup(ctx context.Context, site string, d *unifi.FirewallGroup) (*unifi.FirewallGroup, error) {
+	defer c.invalidate("firewall_group", site)
+	return c.unifiClient.CreateFirewallGroup(ctx, site, d)
+}
+
+func (c *cachingClient) UpdateFirewallGroup(ctx context.Context, site string, d *unifi.FirewallGroup) (*unifi.FirewallGroup, error) {
+	defer c.invalidate("firewall_group", site)
+	return c.unifiClient.UpdateFirewallGroup(ctx, site, d)
+}
+
+func (c *cachingClient) DeleteFirewallGroup(ctx context.Context, site, id string) error {
+	defer c.invalidate("firewall_group", site)
+	return c.unifiClient.DeleteFirewallGroup(ctx, site, id)
+}
+
+func (c *cachingClient) ListFirewallRule(ctx context.Context, site string) ([]unifi.FirewallRule, error) {
+	return cachedList(ctx, c, "firewall_rule", site, c.unifiClient.ListFirewallRule)
+}
+
+func (c *cachingClient) GetFirewallRule(ctx context.Context, site, id string) (*unifi.FirewallRule, error) {
+	return cachedGet(ctx, c, "firewall_rule", site, id, c.unifiClient.ListFirewallRule, func(v unifi.FirewallRule) string { return v.ID })
+}
+
+func (c *cachingClient) CreateFirewallRule(ctx context.Context, site string, d *unifi.FirewallRule) (*unifi.FirewallRule, error) {
+	defer c.invalidate("firewall_rule", site)
+	return c.unifiClient.CreateFirewallRule(ctx, site, d)
+}
+
+func (c *cachingClient) UpdateFirewallRule(ctx context.Context, site string, d *unifi.FirewallRule) (*unifi.FirewallRule, error) {
+	defer c.invalidate("firewall_rule", site)
+	return c.unifiClient.UpdateFirewallRule(ctx, site, d)
+}
+
+func (c *cachingClient) DeleteFirewallRule(ctx context.Context, site, id string) error {
+	defer c.invalidate("firewall_rule", site)
+	return c.unifiClient.DeleteFirewallRule(ctx, site, id)
+}
+
+func (c *cachingClient) ListWLANGroup(ctx context.Context, site string) ([]unifi.WLANGroup, error) {
+	return cachedList(ctx, c, "wlan_group", site, c.unifiClient.ListWLANGroup)
+}
+
+func (c *cachingClient) GetWLANGroup(ctx context.Context, site, id string) (*unifi.WLANGroup, error) {
+	return cachedGet(ctx, c, "wlan_group", site, id, c.unifiClient.ListWLANGroup, func(v unifi.WLANGroup) string { return v.ID })
+}
+
+func (c *cachingClient) CreateWLANGroup(ctx context.Context, site string, d *unifi.WLANGroup) (*unifi.WLANGroup, error) {
+	defer c.invalidate("wlan_group", site)
+	return c.unifiClient.CreateWLANGroup(ctx, site, d)
+}
+
+func (c *cachingClient) UpdateWLANGroup(ctx context.Context, site string, d *unifi.WLANGroup) (*unifi.WLANGroup, error) {
+	defer c.invalidate("wlan_group", site)
+	return c.unifiClient.UpdateWLANGroup(ctx, site, d)
+}
+
+func (c *cachingClient) DeleteWLANGroup(ctx context.Context, site, id string) error {
+	defer c.invalidate("wlan_group", site)
+	return c.unifiClient.DeleteWLANGroup(ctx, site, id)
+}
+
+func (c *cachingClient) ListAPGroup(ctx context.Context, site string) ([]unifi.APGroup, error) {
+	return cachedList(ctx, c, "ap_group", site, c.unifiClient.ListAPGroup)
+}
+
+func (c *cachingClient) GetAPGroup(ctx context.Context, site, id string) (*unifi.APGroup, error) {
+	return cachedGet(ctx, c, "ap_group", site, id, c.unifiClient.ListAPGroup, func(v unifi.APGroup) string { return v.ID })
+}
+
+func (c *cachingClient) CreateAPGroup(ctx context.Context, site string, d *unifi.APGroup) (*unifi.APGroup, error) {
+	defer c.invalidate("ap_group", site)
+	return c.unifiClient.CreateAPGroup(ctx, site, d)
+}
+
+func (c *cachingClient) UpdateAPGroup(ctx context.Context, site string, d *unifi.APGroup) (*unifi.APGroup, error) {
+	defer c.invalidate("ap_group", site)
+	return c.unifiClient.UpdateAPGroup(ctx, site, d)
+}
+
+func (c *cachingClient) DeleteAPGroup(ctx context.Context, site, id string) error {
+	defer c.invalidate("ap_group", site)
+	return c.unifiClient.DeleteAPGroup(ctx, site, id)
+}
+
+func (c *cachingClient) ListNetwork(ctx context.Context, site string) ([]unifi.Network, error) {
+	return cachedList(ctx, c, "network", site, c.unifiClient.ListNetwork)
+}
+
+func (c *cachingClient) GetNetwork(ctx context.Context, site, id string) (*unifi.Network, error) {
+	return cachedGet(ctx, c, "network", site, id, c.unifiClient.ListNetwork, func(v unifi.Network) string { return v.ID })
+}
+
+func (c *cachingClient) CreateNetwork(ctx context.Context, site string, d *unifi.Network) (*unifi.Network, error) {
+	defer c.invalidate("network", site)
+	return c.unifiClient.CreateNetwork(ctx, site, d)
+}
+
+func (c *cachingClient) UpdateNetwork(ctx context.Context, site string, d *unifi.Network) (*unifi.Network, error) {
+	defer c.invalidate("network", site)
+	return c.unifiClient.UpdateNetwork(ctx, site, d)
+}
+
+func (c *cachingClient) DeleteNetwork(ctx context.Context, site, id, name string) error {
+	defer c.invalidate("network", site)
+	return c.unifiClient.DeleteNetwork(ctx, site, id, name)
+}
+
+func (c *cachingClient) UpdateNetworkDHCPOptionValues(ctx context.Context, site, id string, values map[string]string) error {
+	defer c.invalidate("network", site)
+	return c.unifiClient.UpdateNetworkDHCPOptionValues(ctx, site, id, values)
+}
+
+func (c *cachingClient) ListDHCPOption(ctx context.Context, site string) ([]unifi.DHCPOption, error) {
+	return cachedList(ctx, c, "dhcp_option", site, c.unifiClient.ListDHCPOption)
+}
+
+func (c *cachingClient) GetDHCPOption(ctx context.Context, site, id string) (*unifi.DHCPOption, error) {
+	return cachedGet(ctx, c, "dhcp_option", site, id, c.unifiClient.ListDHCPOption, func(v unifi.DHCPOption) string { return v.ID })
+}
+
+func (c *cachingClient) CreateDHCPOption(ctx context.Context, site string, d *unifi.DHCPOption) (*unifi.DHCPOption, error) {
+	defer c.invalidate("dhcp_option", site)
+	return c.unifiClient.CreateDHCPOption(ctx, site, d)
+}
+
+func (c *cachingClient) UpdateDHCPOption(ctx context.Context, site string, d *unifi.DHCPOption) (*unifi.DHCPOption, error) {
+	defer c.invalidate("dhcp_option", site)
+	return c.unifiClient.UpdateDHCPOption(ctx, site, d)
+}
+
+func (c *cachingClient) DeleteDHCPOption(ctx context.Context, site, id string) error {
+	defer c.invalidate("dhcp_option", site)
+	return c.unifiClient.DeleteDHCPOption(ctx, site, id)
+}
+
+func (c *cachingClient) ListRADIUSProfile(ctx context.Context, site string) ([]unifi.RADIUSProfile, error) {
+	return cachedList(ctx, c, "radius_profile", site, c.unifiClient.ListRADIUSProfile)
+}
+
+func (c *cachingClient) GetRADIUSProfile(ctx context.Context, site, id string) (*unifi.RADIUSProfile, error) {
+	return cachedGet(ctx, c, "radius_profile", site, id, c.unifiClient.ListRADIUSProfile, func(v unifi.RADIUSProfile) string { return v.ID })
+}
+
+func (c *cachingClient) CreateRADIUSProfile(ctx context.Context, site string, d *unifi.RADIUSProfile) (*unifi.RADIUSProfile, error) {
+	defer c.invalidate("radius_profile", site)
+	return c.unifiClient.CreateRADIUSProfile(ctx, site, d)
+}
+
+func (c *cachingClient) UpdateRADIUSProfile(ctx context.Context, site string, d *unifi.RADIUSProfile) (*unifi.RADIUSProfile, error) {
+	defer c.invalidate("radius_profile", site)
+	return c.unifiClient.UpdateRADIUSProfile(ctx, site, d)
+}
+
+func (c *cachingClient) DeleteRADIUSProfile(ctx context.Context, site, id string) error {
+	defer c.invalidate("radius_profile", site)
+	return c.unifiClient.DeleteRADIUSProfile(ctx, site, id)
+}
+
+func (c *cachingClient) ListAccounts(ctx context.Context, site string) ([]unifi.Account, error) {
+	return cachedList(ctx, c, "account", site, c.unifiClient.ListAccounts)
+}
+
+func (c *cachingClient) GetAccount(ctx context.Context, site, id string) (*unifi.Account, error) {
+	return cachedGet(ctx, c, "account", site, id, c.unifiClient.ListAccounts, func(v unifi.Account) string { return v.ID })
+}
+
+func (c *cachingClient) CreateAccount(ctx context.Context, site string, d *unifi.Account) (*unifi.Account, error) {
+	defer c.invalidate("account", site)
+	return c.unifiClient.CreateAccount(ctx, site, d)
+}
+
+func (c *cachingClient) UpdateAccount(ctx context.Context, site string, d *unifi.Account) (*unifi.Account, error) {
+	defer c.invalidate("account", site)
+	return c.unifiClient.UpdateAccount(ctx, site, d)
+}
+
+func (c *cachingClient) DeleteAccount(ctx context.Context, site, id string) error {
+	defer c.invalidate("account", site)
+	return c.unifiClient.DeleteAccount(ctx, site, id)
+}
+
+func (c *cachingClient) ListPortProfile(ctx context.Context, site string) ([]unifi.PortProfile, error) {
+	return cachedList(ctx, c, "port_profile", site, c.unifiClient.ListPortProfile)
+}
+
+func (c *cachingClient) GetPortProfile(ctx context.Context, site, id string) (*unifi.PortProfile, error) {
+	return cachedGet(ctx, c, "port_profile", site, id, c.unifiClient.ListPortProfile, func(v unifi.PortProfile) string { return v.ID })
+}
+
+func (c *cachingClient) CreatePortProfile(ctx context.Context, site string, d *unifi.PortProfile) (*unifi.PortProfile, error) {
+	defer c.invalidate("port_profile", site)
+	return c.unifiClient.CreatePortProfile(ctx, site, d)
+}
+
+func (c *cachingClient) UpdatePortProfile(ctx context.Context, site string, d *unifi.PortProfile) (*unifi.PortProfile, error) {
+	defer c.invalidate("port_profile", site)
+	return c.unifiClient.UpdatePortProfile(ctx, site, d)
+}
+
+func (c *cachingClient) DeletePortProfile(ctx context.Context, site, id string) error {
+	defer c.invalidate("port_profile", site)
+	return c.unifiClient.DeletePortProfile(ctx, site, id)
+}
+
+func (c *cachingClient) ListRouting(ctx context.Context, site string) ([]unifi.Routing, error) {
+	return cachedList(ctx, c, "routing", site, c.unifiClient.ListRouting)
+}
+
+func (c *cachingClient) GetRouting(ctx context.Context, site, id string) (*unifi.Routing, error) {
+	return cachedGet(ctx, c, "routing", site, id, c.unifiClient.ListRouting, func(v unifi.Routing) string { return v.ID })
+}
+
+func (c *cachingClient) CreateRouting(ctx context.Context, site string, d *unifi.Routing) (*unifi.Routing, error) {
+	defer c.invalidate("routing", site)
+	return c.unifiClient.CreateRouting(ctx, site, d)
+}
+
+func (c *cachingClient) UpdateRouting(ctx context.Context, site string, d *unifi.Routing) (*unifi.Routing, error) {
+	defer c.invalidate("routing", site)
+	return c.unifiClient.UpdateRouting(ctx, site, d)
+}
+
+func (c *cachingClient) DeleteRouting(ctx context.Context, site, id string) error {
+	defer c.invalidate("routing", site)
+	return c.unifiClient.DeleteRouting(ctx, site, id)
+}
+
+func (c *cachingClient) ListDynamicDNS(ctx context.Context, site string) ([]unifi.DynamicDNS, error) {
+	return cachedList(ctx, c, "dynamic_dns", site, c.unifiClient.ListDynamicDNS)
+}
+
+func (c *cachingClient) GetDynamicDNS(ctx context.Context, site, id string) (*unifi.DynamicDNS, error) {
+	return cachedGet(ctx, c, "dynamic_dns", site, id, c.unifiClient.ListDynamicDNS, func(v unifi.DynamicDNS) string { return v.ID })
+}
+
+func (c *cachingClient) CreateDynamicDNS(ctx context.Context, site string, d *unifi.DynamicDNS) (*unifi.DynamicDNS, error) {
+	defer c.invalidate("dynamic_dns", site)
+	return c.unifiClient.CreateDynamicDNS(ctx, site, d)
+}
+
+func (c *cachingClient) UpdateDynamicDNS(ctx context.Context, site string, d *unifi.DynamicDNS) (*unifi.DynamicDNS, error) {
+	defer c.invalidate("dynamic_dns", site)
+	return c.unifiClient.UpdateDynamicDNS(ctx, site, d)
+}
+
+func (c *cachingClient) DeleteDynamicDNS(ctx context.Context, site, id string) error {
+	defer c.invalidate("dynamic_dns", site)
+	return c.unifiClient.DeleteDynamicDNS(ctx, site, id)
+}
+
+func (c *cachingClient) ListUser(ctx context.Context, site string) ([]unifi.User, error) {
+	return cachedList(ctx, c, "user", site, c.unifiClient.ListUser)
+}
+
+// GetUser is served from the cached list, GetUserByMAC is not cached as it uses a
+// different endpoint that also returns the current IP address.
+func (c *cachingClient) GetUser(ctx context.Context, site, id string) (*unifi.User, error) {
+	return cachedGet(ctx, c, "user", site, id, c.unifiClient.ListUser, func(v unifi.User) string { return v.ID })
+}
+
+func (c *cachingClient) CreateUser(ctx context.Context, site string, d *unifi.User) (*unifi.User, error) {
+	defer c.invalidate("user", site)
+	return c.unifiClient.CreateUser(ctx, site, d)
+}
+
+func (c *cachingClient) UpdateUser(ctx context.Context, site string, d *unifi.User) (*unifi.User, error) {
+	defer c.invalidate("user", site)
+	return c.unifiClient.UpdateUser(ctx, site, d)
+}
+
+func (c *cachingClient) DeleteUserByMAC(ctx context.Context, site, mac string) error {
+	defer c.invalidate("user", site)
+	return c.unifiClient.DeleteUserByMAC(ctx, site, mac)
+}
+
+func (c *cachingClient) BlockUserByMAC(ctx context.Context, site, mac string) error {
+	defer c.invalidate("user", site)
+	return c.unifiClient.BlockUserByMAC(ctx, site, mac)
+}
+
+func (c *cachingClient) UnblockUserByMAC(ctx context.Context, site, mac string) error {
+	defer c.invalidate("user", site)
+	return c.unifiClient.UnblockUserByMAC(ctx, site, mac)
+}
+
+func (c *cachingClient) OverrideUserFingerprint(ctx context.Context, site, mac string, devIdOveride int) error {
+	defer c.invalidate("user", site)
+	return c.unifiClient.OverrideUserFingerprint(ctx, site, mac, devIdOveride)
+}
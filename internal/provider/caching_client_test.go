@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/paultyng/go-unifi/unifi"
+)
+
+// countingNetworkClient implements only the network calls used by the tests, counting
+// the list requests that reach it.
+type countingNetworkClient struct {
+	unifiClient
+
+	mu       sync.Mutex
+	lists    map[string]int
+	networks []unifi.Network
+}
+
+func (c *countingNetworkClient) ListNetwork(ctx context.Context, site string) ([]unifi.Network, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lists[site]++
+	return append([]unifi.Network(nil), c.networks...), nil
+}
+
+func (c *countingNetworkClient) CreateNetwork(ctx context.Context, site string, d *unifi.Network) (*unifi.Network, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.networks = append(c.networks, *d)
+	return d, nil
+}
+
+func TestCachingClient(t *testing.T) {
+	ctx := context.Background()
+	inner := &countingNetworkClient{
+		lists: map[string]int{},
+		networks: []unifi.Network{
+			{ID: "1", Name: "LAN"},
+			{ID: "2", Name: "IoT"},
+		},
+	}
+	c := newCachingClient(inner)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.GetNetwork(ctx, "default", "2"); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if inner.lists["default"] != 1 {
+		t.Fatalf("expected 1 list request, got %d", inner.lists["default"])
+	}
+
+	n, err := c.GetNetwork(ctx, "default", "2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n.Name != "IoT" {
+		t.Fatalf("expected network IoT, got %q", n.Name)
+	}
+
+	// modifying a returned value must not leak into the cache
+	n.Name = "changed"
+	if n, _ = c.GetNetwork(ctx, "default", "2"); n.Name != "IoT" {
+		t.Fatalf("expected cached network to be unchanged, got %q", n.Name)
+	}
+
+	if _, err = c.GetNetwork(ctx, "default", "3"); err == nil {
+		t.Fatal("expected not found error")
+	} else if _, ok := err.(*unifi.NotFoundError); !ok {
+		t.Fatalf("expected not found error, got %T", err)
+	}
+
+	if _, err = c.ListNetwork(ctx, "other"); err != nil {
+		t.Fatal(err)
+	}
+	if inner.lists["default"] != 1 || inner.lists["other"] != 1 {
+		t.Fatalf("expected lists to be cached per site, got %v", inner.lists)
+	}
+
+	if _, err = c.CreateNetwork(ctx, "default", &unifi.Network{ID: "3", Name: "Guest"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = c.GetNetwork(ctx, "default", "3"); err != nil {
+		t.Fatalf("expected created network after invalidation: %s", err)
+	}
+	if inner.lists["default"] != 2 {
+		t.Fatalf("expected 2 list requests, got %d", inner.lists["default"])
+	}
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// cronFields are the names and value ranges of the fields of a cron expression.
+var cronFields = []struct {
+	name     string
+	min, max int
+}{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day of month", 1, 31},
+	{"month", 1, 12},
+	{"day of week", 0, 7},
+}
+
+func cronValidate(raw interface{}, key string) ([]string, []error) {
+	v, ok := raw.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected string, got %T", raw)}
+	}
+
+	parts := strings.Fields(v)
+	if len(parts) != len(cronFields) {
+		return nil, []error{fmt.Errorf("invalid cron expression %q: expected %d fields, got %d", v, len(cronFields), len(parts))}
+	}
+
+	for i, part := range parts {
+		f := cronFields[i]
+		for _, item := range strings.Split(part, ",") {
+			if err := cronValidateItem(item, f.min, f.max); err != nil {
+				return nil, []error{fmt.Errorf("invalid cron expression %q: %s %q: %w", v, f.name, item, err)}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// cronValidateItem validates a single value, range or wildcard with an optional step.
+func cronValidateItem(item string, min, max int) error {
+	item, step, hasStep := strings.Cut(item, "/")
+	if hasStep {
+		n, err := strconv.Atoi(step)
+		if err != nil || n < 1 {
+			return fmt.Errorf("step must be a positive number")
+		}
+	}
+
+	if item == "*" {
+		return nil
+	}
+
+	from, to, isRange := strings.Cut(item, "-")
+	if !isRange {
+		to = from
+	}
+	lo, err := strconv.Atoi(from)
+	if err != nil {
+		return fmt.Errorf("expected a number, range or *")
+	}
+	hi, err := strconv.Atoi(to)
+	if err != nil {
+		return fmt.Errorf("expected a number, range or *")
+	}
+	if lo < min || hi > max {
+		return fmt.Errorf("must be between %d and %d", min, max)
+	}
+	if lo > hi {
+		return fmt.Errorf("start of range must not be after its end")
+	}
+
+	return nil
+}
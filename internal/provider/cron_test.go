@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestCronValidate(t *testing.T) {
+	for _, c := range []struct {
+		expectedError string
+		expr          string
+	}{
+		{`invalid cron expression "": expected 5 fields, got 0`, ""},
+		{`invalid cron expression "0 3 * *": expected 5 fields, got 4`, "0 3 * *"},
+		{`invalid cron expression "60 3 * * *": minute "60": must be between 0 and 59`, "60 3 * * *"},
+		{`invalid cron expression "0 3 0 * *": day of month "0": must be between 1 and 31`, "0 3 0 * *"},
+		{`invalid cron expression "0 5-3 * * *": hour "5-3": start of range must not be after its end`, "0 5-3 * * *"},
+		{`invalid cron expression "*/0 * * * *": minute "*/0": step must be a positive number`, "*/0 * * * *"},
+		{`invalid cron expression "0 3 * * MON": day of week "MON": expected a number, range or *`, "0 3 * * MON"},
+
+		{"", "0 3 * * *"},
+		{"", "*/15 1-5 1,15 * 0,6"},
+		{"", "30 2 * 1-12/3 7"},
+	} {
+		t.Run(c.expr, func(t *testing.T) {
+			_, actualErrs := cronValidate(c.expr, "key")
+			switch len(actualErrs) {
+			case 0:
+				if c.expectedError != "" {
+					t.Fatalf("expected error %q, got none", c.expectedError)
+				}
+			case 1:
+				actualErr := actualErrs[0].Error()
+				if actualErr != c.expectedError {
+					t.Fatalf("expected %q, got %q", c.expectedError, actualErr)
+				}
+			default:
+				t.Fatalf("expected 0 or 1 errors, got %d: %#v", len(actualErrs), actualErrs)
+			}
+		})
+	}
+}
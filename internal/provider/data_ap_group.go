@@ -2,11 +2,16 @@
 
 import (
 	"context"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// defaultGroupHiddenID is the attr_hidden_id of the built-in AP and WLAN groups, the controller
+// spells it "default" for AP groups and "Default" for WLAN groups.
+const defaultGroupHiddenID = "default"
+
 func dataAPGroup() *schema.Resource {
 	return &schema.Resource{
 		Description: "`unifi_ap_group` data source can be used to retrieve the ID for an AP group by name.",
@@ -30,6 +35,14 @@ func dataAPGroup() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 			},
+			"device_macs": {
+				Description: "The MAC addresses of the access points in the AP group.",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -48,9 +61,10 @@ func dataAPGroupRead(ctx context.Context, d *schema.ResourceData, meta interface
 		return diag.FromErr(err)
 	}
 	for _, g := range groups {
-		if (name == "" && g.HiddenID == "default") || g.Name == name {
+		if (name == "" && strings.EqualFold(g.HiddenID, defaultGroupHiddenID)) || g.Name == name {
 			d.SetId(g.ID)
 			d.Set("site", site)
+			d.Set("device_macs", stringSliceToSet(g.DeviceMACs))
 			return nil
 		}
 	}
@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataDevices() *schema.Resource {
+	return &schema.Resource{
+		Description: "`unifi_devices` retrieves the devices (access points, switches and gateways) known to the controller, " +
+			"optionally filtered by type, model or name.",
+
+		ReadContext: dataDevicesRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of this data source, which is the name of the site.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"site": {
+				Description: "The name of the site the devices are associated with.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Optional:    true,
+			},
+
+			// filters
+			"type": {
+				Description:  "Only return devices of this type, valid values are `uap`, `usw`, `ugw` and `udm`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"uap", "usw", "ugw", "udm"}, false),
+			},
+			"model": {
+				Description: "Only return devices of this model, for example `US24P250`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"name_regex": {
+				Description:  "Only return devices whose name matches this regular expression.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+
+			// read-only / computed
+			"devices": {
+				Description: "The devices matching the filters, sorted by MAC address.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The ID of the device.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"mac": {
+							Description: "The MAC address of the device.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the device.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"model": {
+							Description: "The model of the device.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"type": {
+							Description: "The type of the device, for example `uap`, `usw`, `ugw` or `udm`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"firmware_version": {
+							Description: "The firmware version of the device.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"ip": {
+							Description: "The IP address of the device.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"adopted": {
+							Description: "Whether the device is adopted by the controller.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"state": {
+							Description: "The state of the device, for example `Connected`, `Pending` or `HeartbeatMissed`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataDevicesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+
+	deviceType := d.Get("type").(string)
+	model := d.Get("model").(string)
+
+	var nameRegexp *regexp.Regexp
+	if v := d.Get("name_regex").(string); v != "" {
+		nameRegexp = regexp.MustCompile(v)
+	}
+
+	resp, err := c.c.ListDevice(ctx, site)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].MAC < resp[j].MAC
+	})
+
+	devices := []map[string]interface{}{}
+	for _, dev := range resp {
+		switch {
+		case deviceType != "" && dev.Type != deviceType,
+			model != "" && dev.Model != model,
+			nameRegexp != nil && !nameRegexp.MatchString(dev.Name):
+			continue
+		}
+
+		devices = append(devices, map[string]interface{}{
+			"id":               dev.ID,
+			"mac":              dev.MAC,
+			"name":             dev.Name,
+			"model":            dev.Model,
+			"type":             dev.Type,
+			"firmware_version": dev.Version,
+			"ip":               dev.IP,
+			"adopted":          dev.Adopted,
+			"state":            dev.State.String(),
+		})
+	}
+
+	d.SetId(site)
+	d.Set("site", site)
+	d.Set("devices", devices)
+
+	return nil
+}
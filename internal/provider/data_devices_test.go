@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataDevices_filters(t *testing.T) {
+	site := "default"
+
+	device, unallocateDevice := allocateDevice(t)
+	defer unallocateDevice()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			preCheck(t)
+			preCheckDeviceExists(t, site, device.MAC)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataDevicesConfig(device.Model),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.unifi_devices.switches", "devices.*", map[string]string{
+						"id":      device.ID,
+						"mac":     device.MAC,
+						"model":   device.Model,
+						"type":    "usw",
+						"adopted": "true",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.unifi_devices.model", "devices.*", map[string]string{
+						"mac": device.MAC,
+					}),
+					resource.TestCheckResourceAttr("data.unifi_devices.access_points_of_model", "devices.#", "0"),
+					resource.TestCheckResourceAttr("data.unifi_devices.no_match", "devices.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataDevicesConfig(model string) string {
+	return fmt.Sprintf(`
+data "unifi_devices" "switches" {
+	type = "usw"
+}
+
+data "unifi_devices" "model" {
+	model = %[1]q
+}
+
+data "unifi_devices" "access_points_of_model" {
+	type  = "uap"
+	model = %[1]q
+}
+
+data "unifi_devices" "no_match" {
+	name_regex = "^tfacc-no-such-device$"
+}
+`, model)
+}
@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataUsers() *schema.Resource {
+	return &schema.Resource{
+		Description: "`unifi_users` retrieves all known users (or \"clients\" in the UI) of the network, " +
+			"optionally filtered by user group, network, name, note, blocked state or fixed IP.",
+
+		ReadContext: dataUsersRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of this data source, which is the name of the site.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"site": {
+				Description: "The name of the site the users are associated with.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Optional:    true,
+			},
+
+			// filters
+			"user_group_id": {
+				Description: "Only return users in this user group.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"network_id": {
+				Description: "Only return users assigned to this network.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"name_regex": {
+				Description:  "Only return users whose name matches this regular expression.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"note_regex": {
+				Description:  "Only return users whose note matches this regular expression.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"blocked": {
+				Description: "Only return users that are (`true`) or are not (`false`) blocked.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"has_fixed_ip": {
+				Description: "Only return users that have (`true`) or do not have (`false`) a fixed IP.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+
+			// read-only / computed
+			"users": {
+				Description: "The users matching the filters, sorted by MAC address.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The ID of the user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"mac": {
+							Description: "The MAC address of the user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"hostname": {
+							Description: "The hostname of the user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"note": {
+							Description: "A note with additional information for the user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"user_group_id": {
+							Description: "The user group ID for the user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"network_id": {
+							Description: "The network ID for this user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"fixed_ip": {
+							Description: "fixed IPv4 address set for this user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"blocked": {
+							Description: "Specifies whether this user is blocked from the network.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"local_dns_record": {
+							Description: "The local DNS record for this user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataUsersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+
+	userGroupID := d.Get("user_group_id").(string)
+	networkID := d.Get("network_id").(string)
+
+	var nameRegexp, noteRegexp *regexp.Regexp
+	if v := d.Get("name_regex").(string); v != "" {
+		nameRegexp = regexp.MustCompile(v)
+	}
+	if v := d.Get("note_regex").(string); v != "" {
+		noteRegexp = regexp.MustCompile(v)
+	}
+
+	// booleans are only filtered on when set, false is a valid filter value
+	blocked := d.GetRawConfig().GetAttr("blocked")
+	hasFixedIP := d.GetRawConfig().GetAttr("has_fixed_ip")
+
+	resp, err := c.c.ListUser(ctx, site)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].MAC < resp[j].MAC
+	})
+
+	users := []map[string]interface{}{}
+	for _, u := range resp {
+		fixedIP := ""
+		if u.UseFixedIP {
+			fixedIP = u.FixedIP
+		}
+
+		switch {
+		case userGroupID != "" && u.UserGroupID != userGroupID,
+			networkID != "" && u.NetworkID != networkID,
+			nameRegexp != nil && !nameRegexp.MatchString(u.Name),
+			noteRegexp != nil && !noteRegexp.MatchString(u.Note),
+			!blocked.IsNull() && blocked.True() != u.Blocked,
+			!hasFixedIP.IsNull() && hasFixedIP.True() != (fixedIP != ""):
+			continue
+		}
+
+		localDNSRecord := ""
+		if u.LocalDNSRecordEnabled {
+			localDNSRecord = u.LocalDNSRecord
+		}
+
+		users = append(users, map[string]interface{}{
+			"id":               u.ID,
+			"mac":              u.MAC,
+			"name":             u.Name,
+			"hostname":         u.Hostname,
+			"note":             u.Note,
+			"user_group_id":    u.UserGroupID,
+			"network_id":       u.NetworkID,
+			"fixed_ip":         fixedIP,
+			"blocked":          u.Blocked,
+			"local_dns_record": localDNSRecord,
+		})
+	}
+
+	d.SetId(site)
+	d.Set("site", site)
+	d.Set("users", users)
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/paultyng/go-unifi/unifi"
+)
+
+func TestAccDataUsers_filters(t *testing.T) {
+	name := acctest.RandomWithPrefix("tfacc")
+
+	mac1, unallocateTestMac1 := allocateTestMac(t)
+	defer unallocateTestMac1()
+	mac2, unallocateTestMac2 := allocateTestMac(t)
+	defer unallocateTestMac2()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			preCheck(t)
+
+			for _, u := range []*unifi.User{
+				{MAC: mac1, Name: name + "-1", Note: "iot"},
+				{MAC: mac2, Name: name + "-2", Note: "office"},
+			} {
+				created, err := testClient.CreateUser(context.Background(), "default", u)
+				if err != nil {
+					t.Fatal(err)
+				}
+				t.Cleanup(func() {
+					_ = testClient.DeleteUserByMAC(context.Background(), "default", created.MAC)
+				})
+			}
+
+			// blocking is a separate command, it is ignored on create
+			if err := testClient.BlockUserByMAC(context.Background(), "default", mac2); err != nil {
+				t.Fatal(err)
+			}
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataUsersConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.unifi_users.name", "users.#", "2"),
+					resource.TestCheckResourceAttr("data.unifi_users.note", "users.#", "1"),
+					resource.TestCheckResourceAttr("data.unifi_users.note", "users.0.mac", mac1),
+					resource.TestCheckResourceAttr("data.unifi_users.blocked", "users.#", "1"),
+					resource.TestCheckResourceAttr("data.unifi_users.blocked", "users.0.mac", mac2),
+					resource.TestCheckResourceAttr("data.unifi_users.not_blocked", "users.#", "1"),
+					resource.TestCheckResourceAttr("data.unifi_users.not_blocked", "users.0.mac", mac1),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataUsersConfig(name string) string {
+	return fmt.Sprintf(`
+data "unifi_users" "name" {
+	name_regex = "^%[1]s-"
+}
+
+data "unifi_users" "note" {
+	name_regex = "^%[1]s-"
+	note_regex = "iot"
+}
+
+data "unifi_users" "blocked" {
+	name_regex = "^%[1]s-"
+	blocked    = true
+}
+
+data "unifi_users" "not_blocked" {
+	name_regex = "^%[1]s-"
+	blocked    = false
+}
+`, name)
+}
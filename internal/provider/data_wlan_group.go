@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataWLANGroup() *schema.Resource {
+	return &schema.Resource{
+		Description: "`unifi_wlan_group` data source can be used to retrieve the ID for a WLAN group by name.",
+
+		ReadContext: dataWLANGroupRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of this WLAN group.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"site": {
+				Description: "The name of the site the WLAN group is associated with.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Optional:    true,
+			},
+			"name": {
+				Description: "The name of the WLAN group to look up, leave blank to look up the default WLAN group.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func dataWLANGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	name := d.Get("name").(string)
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+
+	groups, err := c.c.ListWLANGroup(ctx, site)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	for _, g := range groups {
+		if (name == "" && strings.EqualFold(g.HiddenID, defaultGroupHiddenID)) || g.Name == name {
+			d.SetId(g.ID)
+			d.Set("site", site)
+			return nil
+		}
+	}
+
+	return diag.Errorf("WLAN group not found with name %s", name)
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataWLANGroup_default(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			preCheck(t)
+		},
+		ProviderFactories: providerFactories,
+		// TODO: CheckDestroy: ,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataWLANGroupConfig_default,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.unifi_wlan_group.default", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataWLANGroup_byName(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			preCheck(t)
+		},
+		ProviderFactories: providerFactories,
+		// TODO: CheckDestroy: ,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataWLANGroupConfig_byName,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.unifi_wlan_group.test", "id", "unifi_wlan_group.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataWLANGroupConfig_default = `
+data "unifi_wlan_group" "default" {
+}
+`
+
+const testAccDataWLANGroupConfig_byName = `
+resource "unifi_wlan_group" "test" {
+	name = "tfacc-data"
+}
+
+data "unifi_wlan_group" "test" {
+	name = unifi_wlan_group.test.name
+}
+`
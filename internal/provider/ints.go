@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func setToIntSlice(src *schema.Set) ([]int, error) {
+	dst := make([]int, 0, src.Len())
+	for _, v := range src.List() {
+		i, ok := v.(int)
+		if !ok {
+			return nil, fmt.Errorf("unable to convert %v (%T) to int", v, v)
+		}
+		dst = append(dst, i)
+	}
+	return dst, nil
+}
+
+func intSliceToSet(src []int) *schema.Set {
+	vs := make([]interface{}, 0, len(src))
+	for _, v := range src {
+		vs = append(vs, v)
+	}
+	return schema.NewSet(schema.HashInt, vs)
+}
@@ -19,14 +19,51 @@ type lazyClient struct {
 	baseURL   string
 	user      string
 	pass      string
+	apiKey    string
 	insecure  bool
 	subsystem string
 
+	maxRetries   int
+	retryBackoff time.Duration
+
+	maxConcurrentRequests int
+
 	once  sync.Once
 	inner *unifi.Client
 }
 
-func setHTTPClient(c *unifi.Client, insecure bool, subsystem string) {
+// limitTransport caps the number of requests in flight to the controller.
+type limitTransport struct {
+	sem  chan struct{}
+	next http.RoundTripper
+
+	// csrfToken returns the current CSRF token of the client, which may have been
+	// refreshed by another request while this one was waiting for a slot
+	csrfToken func() string
+}
+
+func (t *limitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	select {
+	case t.sem <- struct{}{}:
+		defer func() { <-t.sem }()
+	case <-req.Context().Done():
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, req.Context().Err()
+	}
+
+	if req.Header.Get("X-CSRF-Token") != "" {
+		if csrf := t.csrfToken(); csrf != "" {
+			req = req.Clone(req.Context())
+			req.Header.Set("X-CSRF-Token", csrf)
+		}
+	}
+
+	return t.next.RoundTrip(req)
+}
+
+func setHTTPClient(c *unifi.Client, insecure bool, subsystem string, maxConcurrentRequests int) {
 	httpClient := &http.Client{}
 	httpClient.Transport = &http.Transport{
 		Proxy: http.ProxyFromEnvironment,
@@ -47,6 +84,14 @@ func setHTTPClient(c *unifi.Client, insecure bool, subsystem string) {
 
 	httpClient.Transport = logging.NewSubsystemLoggingHTTPTransport(subsystem, httpClient.Transport)
 
+	if maxConcurrentRequests > 0 {
+		httpClient.Transport = &limitTransport{
+			sem:       make(chan struct{}, maxConcurrentRequests),
+			next:      httpClient.Transport,
+			csrfToken: c.CSRFToken,
+		}
+	}
+
 	jar, _ := cookiejar.New(nil)
 	httpClient.Jar = jar
 
@@ -58,14 +103,19 @@ func setHTTPClient(c *unifi.Client, insecure bool, subsystem string) {
 func (c *lazyClient) init(ctx context.Context) error {
 	c.once.Do(func() {
 		c.inner = &unifi.Client{}
-		setHTTPClient(c.inner, c.insecure, c.subsystem)
+		setHTTPClient(c.inner, c.insecure, c.subsystem, c.maxConcurrentRequests)
+		c.inner.SetRetryPolicy(c.maxRetries, c.retryBackoff)
 
 		initErr = c.inner.SetBaseURL(c.baseURL)
 		if initErr != nil {
 			return
 		}
 
-		initErr = c.inner.Login(ctx, c.user, c.pass)
+		if c.apiKey != "" {
+			initErr = c.inner.LoginWithAPIKey(ctx, c.apiKey)
+		} else {
+			initErr = c.inner.Login(ctx, c.user, c.pass)
+		}
 		if initErr != nil {
 			return
 		}
@@ -94,12 +144,60 @@ func (c *lazyClient) ListWLANGroup(ctx context.Context, site string) ([]unifi.WL
 	}
 	return c.inner.ListWLANGroup(ctx, site)
 }
+func (c *lazyClient) DeleteWLANGroup(ctx context.Context, site, id string) error {
+	if err := c.init(ctx); err != nil {
+		return err
+	}
+	return c.inner.DeleteWLANGroup(ctx, site, id)
+}
+func (c *lazyClient) CreateWLANGroup(ctx context.Context, site string, d *unifi.WLANGroup) (*unifi.WLANGroup, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.CreateWLANGroup(ctx, site, d)
+}
+func (c *lazyClient) GetWLANGroup(ctx context.Context, site, id string) (*unifi.WLANGroup, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.GetWLANGroup(ctx, site, id)
+}
+func (c *lazyClient) UpdateWLANGroup(ctx context.Context, site string, d *unifi.WLANGroup) (*unifi.WLANGroup, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.UpdateWLANGroup(ctx, site, d)
+}
 func (c *lazyClient) ListAPGroup(ctx context.Context, site string) ([]unifi.APGroup, error) {
 	if err := c.init(ctx); err != nil {
 		return nil, err
 	}
 	return c.inner.ListAPGroup(ctx, site)
 }
+func (c *lazyClient) DeleteAPGroup(ctx context.Context, site, id string) error {
+	if err := c.init(ctx); err != nil {
+		return err
+	}
+	return c.inner.DeleteAPGroup(ctx, site, id)
+}
+func (c *lazyClient) CreateAPGroup(ctx context.Context, site string, d *unifi.APGroup) (*unifi.APGroup, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.CreateAPGroup(ctx, site, d)
+}
+func (c *lazyClient) GetAPGroup(ctx context.Context, site, id string) (*unifi.APGroup, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.GetAPGroup(ctx, site, id)
+}
+func (c *lazyClient) UpdateAPGroup(ctx context.Context, site string, d *unifi.APGroup) (*unifi.APGroup, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.UpdateAPGroup(ctx, site, d)
+}
 func (c *lazyClient) DeleteNetwork(ctx context.Context, site, id, name string) error {
 	if err := c.init(ctx); err != nil {
 		return err
@@ -130,6 +228,18 @@ func (c *lazyClient) UpdateNetwork(ctx context.Context, site string, d *unifi.Ne
 	}
 	return c.inner.UpdateNetwork(ctx, site, d)
 }
+func (c *lazyClient) GetNetworkDHCPOptionValues(ctx context.Context, site, id string) (map[string]string, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.GetNetworkDHCPOptionValues(ctx, site, id)
+}
+func (c *lazyClient) UpdateNetworkDHCPOptionValues(ctx context.Context, site, id string, values map[string]string) error {
+	if err := c.init(ctx); err != nil {
+		return err
+	}
+	return c.inner.UpdateNetworkDHCPOptionValues(ctx, site, id, values)
+}
 func (c *lazyClient) DeleteWLAN(ctx context.Context, site, id string) error {
 	if err := c.init(ctx); err != nil {
 		return err
@@ -154,6 +264,12 @@ func (c *lazyClient) UpdateWLAN(ctx context.Context, site string, d *unifi.WLAN)
 	}
 	return c.inner.UpdateWLAN(ctx, site, d)
 }
+func (c *lazyClient) UpdateWLANFields(ctx context.Context, site string, d *unifi.WLAN, fields map[string]interface{}) (*unifi.WLAN, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.UpdateWLANFields(ctx, site, d, fields)
+}
 func (c *lazyClient) DeleteUserGroup(ctx context.Context, site, id string) error {
 	if err := c.init(ctx); err != nil {
 		return err
@@ -202,6 +318,12 @@ func (c *lazyClient) UpdateDevice(ctx context.Context, site string, d *unifi.Dev
 	}
 	return c.inner.UpdateDevice(ctx, site, d)
 }
+func (c *lazyClient) UpdateDeviceFields(ctx context.Context, site string, d *unifi.Device, fields map[string]interface{}) (*unifi.Device, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.UpdateDeviceFields(ctx, site, d, fields)
+}
 func (c *lazyClient) DeleteDevice(ctx context.Context, site, id string) error {
 	if err := c.init(ctx); err != nil {
 		return err
@@ -226,6 +348,12 @@ func (c *lazyClient) ForgetDevice(ctx context.Context, site, mac string) error {
 	}
 	return c.inner.ForgetDevice(ctx, site, mac)
 }
+func (c *lazyClient) ListUser(ctx context.Context, site string) ([]unifi.User, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.ListUser(ctx, site)
+}
 func (c *lazyClient) GetUser(ctx context.Context, site, id string) (*unifi.User, error) {
 	if err := c.init(ctx); err != nil {
 		return nil, err
@@ -589,3 +717,327 @@ func (c *lazyClient) UpdateSettingRadius(ctx context.Context, site string, d *un
 	}
 	return c.inner.UpdateSettingRadius(ctx, site, d)
 }
+func (c *lazyClient) GetSettingNtp(ctx context.Context, site string) (*unifi.SettingNtp, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.GetSettingNtp(ctx, site)
+}
+func (c *lazyClient) UpdateSettingNtp(ctx context.Context, site string, d *unifi.SettingNtp) (*unifi.SettingNtp, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.UpdateSettingNtp(ctx, site, d)
+}
+func (c *lazyClient) GetSettingRsyslogd(ctx context.Context, site string) (*unifi.SettingRsyslogd, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.GetSettingRsyslogd(ctx, site)
+}
+func (c *lazyClient) UpdateSettingRsyslogd(ctx context.Context, site string, d *unifi.SettingRsyslogd) (*unifi.SettingRsyslogd, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.UpdateSettingRsyslogd(ctx, site, d)
+}
+func (c *lazyClient) GetSettingSnmp(ctx context.Context, site string) (*unifi.SettingSnmp, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.GetSettingSnmp(ctx, site)
+}
+func (c *lazyClient) UpdateSettingSnmp(ctx context.Context, site string, d *unifi.SettingSnmp) (*unifi.SettingSnmp, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.UpdateSettingSnmp(ctx, site, d)
+}
+func (c *lazyClient) GetSettingGuestAccess(ctx context.Context, site string) (*unifi.SettingGuestAccess, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.GetSettingGuestAccess(ctx, site)
+}
+func (c *lazyClient) UpdateSettingGuestAccess(ctx context.Context, site string, d *unifi.SettingGuestAccess) (*unifi.SettingGuestAccess, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.UpdateSettingGuestAccess(ctx, site, d)
+}
+func (c *lazyClient) UpdateSettingGuestAccessFields(ctx context.Context, site string, d *unifi.SettingGuestAccess, fields map[string]interface{}) (*unifi.SettingGuestAccess, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.UpdateSettingGuestAccessFields(ctx, site, d, fields)
+}
+func (c *lazyClient) ListHotspotOp(ctx context.Context, site string) ([]unifi.HotspotOp, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.ListHotspotOp(ctx, site)
+}
+func (c *lazyClient) GetHotspotOp(ctx context.Context, site, id string) (*unifi.HotspotOp, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.GetHotspotOp(ctx, site, id)
+}
+func (c *lazyClient) DeleteHotspotOp(ctx context.Context, site, id string) error {
+	if err := c.init(ctx); err != nil {
+		return err
+	}
+	return c.inner.DeleteHotspotOp(ctx, site, id)
+}
+func (c *lazyClient) CreateHotspotOp(ctx context.Context, site string, d *unifi.HotspotOp) (*unifi.HotspotOp, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.CreateHotspotOp(ctx, site, d)
+}
+func (c *lazyClient) UpdateHotspotOp(ctx context.Context, site string, d *unifi.HotspotOp) (*unifi.HotspotOp, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.UpdateHotspotOp(ctx, site, d)
+}
+func (c *lazyClient) ListHotspotPackage(ctx context.Context, site string) ([]unifi.HotspotPackage, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.ListHotspotPackage(ctx, site)
+}
+func (c *lazyClient) GetHotspotPackage(ctx context.Context, site, id string) (*unifi.HotspotPackage, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.GetHotspotPackage(ctx, site, id)
+}
+func (c *lazyClient) DeleteHotspotPackage(ctx context.Context, site, id string) error {
+	if err := c.init(ctx); err != nil {
+		return err
+	}
+	return c.inner.DeleteHotspotPackage(ctx, site, id)
+}
+func (c *lazyClient) CreateHotspotPackage(ctx context.Context, site string, d *unifi.HotspotPackage) (*unifi.HotspotPackage, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.CreateHotspotPackage(ctx, site, d)
+}
+func (c *lazyClient) UpdateHotspotPackage(ctx context.Context, site string, d *unifi.HotspotPackage) (*unifi.HotspotPackage, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.UpdateHotspotPackage(ctx, site, d)
+}
+func (c *lazyClient) UpdateHotspotPackageFields(ctx context.Context, site string, d *unifi.HotspotPackage, fields map[string]interface{}) (*unifi.HotspotPackage, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.UpdateHotspotPackageFields(ctx, site, d, fields)
+}
+func (c *lazyClient) ListDpiApp(ctx context.Context, site string) ([]unifi.DpiApp, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.ListDpiApp(ctx, site)
+}
+func (c *lazyClient) GetDpiApp(ctx context.Context, site, id string) (*unifi.DpiApp, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.GetDpiApp(ctx, site, id)
+}
+func (c *lazyClient) DeleteDpiApp(ctx context.Context, site, id string) error {
+	if err := c.init(ctx); err != nil {
+		return err
+	}
+	return c.inner.DeleteDpiApp(ctx, site, id)
+}
+func (c *lazyClient) CreateDpiApp(ctx context.Context, site string, d *unifi.DpiApp) (*unifi.DpiApp, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.CreateDpiApp(ctx, site, d)
+}
+func (c *lazyClient) UpdateDpiApp(ctx context.Context, site string, d *unifi.DpiApp) (*unifi.DpiApp, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.UpdateDpiApp(ctx, site, d)
+}
+func (c *lazyClient) UpdateDpiAppFields(ctx context.Context, site string, d *unifi.DpiApp, fields map[string]interface{}) (*unifi.DpiApp, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.UpdateDpiAppFields(ctx, site, d, fields)
+}
+func (c *lazyClient) ListDpiGroup(ctx context.Context, site string) ([]unifi.DpiGroup, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.ListDpiGroup(ctx, site)
+}
+func (c *lazyClient) GetDpiGroup(ctx context.Context, site, id string) (*unifi.DpiGroup, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.GetDpiGroup(ctx, site, id)
+}
+func (c *lazyClient) DeleteDpiGroup(ctx context.Context, site, id string) error {
+	if err := c.init(ctx); err != nil {
+		return err
+	}
+	return c.inner.DeleteDpiGroup(ctx, site, id)
+}
+func (c *lazyClient) CreateDpiGroup(ctx context.Context, site string, d *unifi.DpiGroup) (*unifi.DpiGroup, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.CreateDpiGroup(ctx, site, d)
+}
+func (c *lazyClient) UpdateDpiGroup(ctx context.Context, site string, d *unifi.DpiGroup) (*unifi.DpiGroup, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.UpdateDpiGroup(ctx, site, d)
+}
+func (c *lazyClient) UpdateDpiGroupFields(ctx context.Context, site string, d *unifi.DpiGroup, fields map[string]interface{}) (*unifi.DpiGroup, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.UpdateDpiGroupFields(ctx, site, d, fields)
+}
+func (c *lazyClient) GetSettingIps(ctx context.Context, site string) (*unifi.SettingIps, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.GetSettingIps(ctx, site)
+}
+func (c *lazyClient) UpdateSettingIps(ctx context.Context, site string, d *unifi.SettingIps) (*unifi.SettingIps, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.UpdateSettingIps(ctx, site, d)
+}
+func (c *lazyClient) GetSettingGlobalAp(ctx context.Context, site string) (*unifi.SettingGlobalAp, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.GetSettingGlobalAp(ctx, site)
+}
+func (c *lazyClient) UpdateSettingGlobalAp(ctx context.Context, site string, d *unifi.SettingGlobalAp) (*unifi.SettingGlobalAp, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.UpdateSettingGlobalAp(ctx, site, d)
+}
+func (c *lazyClient) GetSettingGlobalSwitch(ctx context.Context, site string) (*unifi.SettingGlobalSwitch, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.GetSettingGlobalSwitch(ctx, site)
+}
+func (c *lazyClient) UpdateSettingGlobalSwitch(ctx context.Context, site string, d *unifi.SettingGlobalSwitch) (*unifi.SettingGlobalSwitch, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.UpdateSettingGlobalSwitch(ctx, site, d)
+}
+func (c *lazyClient) UpdateSettingGlobalSwitchFields(ctx context.Context, site string, d *unifi.SettingGlobalSwitch, fields map[string]interface{}) (*unifi.SettingGlobalSwitch, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.UpdateSettingGlobalSwitchFields(ctx, site, d, fields)
+}
+func (c *lazyClient) ListScheduleTask(ctx context.Context, site string) ([]unifi.ScheduleTask, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.ListScheduleTask(ctx, site)
+}
+func (c *lazyClient) GetScheduleTask(ctx context.Context, site, id string) (*unifi.ScheduleTask, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.GetScheduleTask(ctx, site, id)
+}
+func (c *lazyClient) DeleteScheduleTask(ctx context.Context, site, id string) error {
+	if err := c.init(ctx); err != nil {
+		return err
+	}
+	return c.inner.DeleteScheduleTask(ctx, site, id)
+}
+func (c *lazyClient) CreateScheduleTask(ctx context.Context, site string, d *unifi.ScheduleTask) (*unifi.ScheduleTask, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.CreateScheduleTask(ctx, site, d)
+}
+func (c *lazyClient) UpdateScheduleTask(ctx context.Context, site string, d *unifi.ScheduleTask) (*unifi.ScheduleTask, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.UpdateScheduleTask(ctx, site, d)
+}
+func (c *lazyClient) GetSettingAutoSpeedtest(ctx context.Context, site string) (*unifi.SettingAutoSpeedtest, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.GetSettingAutoSpeedtest(ctx, site)
+}
+func (c *lazyClient) UpdateSettingAutoSpeedtest(ctx context.Context, site string, d *unifi.SettingAutoSpeedtest) (*unifi.SettingAutoSpeedtest, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.UpdateSettingAutoSpeedtest(ctx, site, d)
+}
+func (c *lazyClient) ListDHCPOption(ctx context.Context, site string) ([]unifi.DHCPOption, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.ListDHCPOption(ctx, site)
+}
+func (c *lazyClient) GetDHCPOption(ctx context.Context, site, id string) (*unifi.DHCPOption, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.GetDHCPOption(ctx, site, id)
+}
+func (c *lazyClient) DeleteDHCPOption(ctx context.Context, site, id string) error {
+	if err := c.init(ctx); err != nil {
+		return err
+	}
+	return c.inner.DeleteDHCPOption(ctx, site, id)
+}
+func (c *lazyClient) CreateDHCPOption(ctx context.Context, site string, d *unifi.DHCPOption) (*unifi.DHCPOption, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.CreateDHCPOption(ctx, site, d)
+}
+func (c *lazyClient) UpdateDHCPOption(ctx context.Context, site string, d *unifi.DHCPOption) (*unifi.DHCPOption, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.UpdateDHCPOption(ctx, site, d)
+}
+func (c *lazyClient) GetSettingSuperMgmt(ctx context.Context, site string) (*unifi.SettingSuperMgmt, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.GetSettingSuperMgmt(ctx, site)
+}
+func (c *lazyClient) UpdateSettingSuperMgmt(ctx context.Context, site string, d *unifi.SettingSuperMgmt) (*unifi.SettingSuperMgmt, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.UpdateSettingSuperMgmt(ctx, site, d)
+}
+func (c *lazyClient) UpdateSettingSuperMgmtFields(ctx context.Context, site string, d *unifi.SettingSuperMgmt, fields map[string]interface{}) (*unifi.SettingSuperMgmt, error) {
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+	return c.inner.UpdateSettingSuperMgmtFields(ctx, site, d, fields)
+}
@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"testing"
+	"time"
+)
+
+// stubController emulates the login, status and site list endpoints of a classic
+// (non UniFi OS) controller, failing site list requests with the queued status codes
+// and delaying each of them by latency.
+type stubController struct {
+	*httptest.Server
+
+	latency time.Duration
+
+	mu          sync.Mutex
+	logins      int
+	requests    int
+	inFlight    int
+	maxInFlight int
+	failures    []int
+}
+
+func newStubController(t testing.TB, failures ...int) *stubController {
+	s := &stubController{failures: failures}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/manage", http.StatusFound)
+	})
+	mux.HandleFunc("/api/login", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.logins++
+		s.mu.Unlock()
+
+		http.SetCookie(w, &http.Cookie{Name: "unifises", Value: "session"})
+		writeStubResponse(w, http.StatusOK)
+	})
+	mux.HandleFunc("/status", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"meta": map[string]interface{}{
+				"rc":             "ok",
+				"server_version": "7.3.83",
+			},
+		})
+	})
+	mux.HandleFunc("/api/self/sites", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.requests++
+		s.inFlight++
+		if s.inFlight > s.maxInFlight {
+			s.maxInFlight = s.inFlight
+		}
+		status := http.StatusOK
+		if len(s.failures) > 0 {
+			status, s.failures = s.failures[0], s.failures[1:]
+		}
+		s.mu.Unlock()
+
+		time.Sleep(s.latency)
+		writeStubResponse(w, status)
+
+		s.mu.Lock()
+		s.inFlight--
+		s.mu.Unlock()
+	})
+
+	s.Server = httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func writeStubResponse(w http.ResponseWriter, status int) {
+	rc := "ok"
+	if status != http.StatusOK {
+		rc = "error"
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"meta": map[string]interface{}{
+			"rc":  rc,
+			"msg": http.StatusText(status),
+		},
+		"data": []interface{}{},
+	})
+}
+
+func TestLazyClient_retry(t *testing.T) {
+	for _, c := range []struct {
+		name             string
+		failures         []int
+		maxRetries       int
+		expectError      bool
+		expectedLogins   int
+		expectedRequests int
+	}{
+		{"success", nil, 3, false, 1, 1},
+		{"session expired", []int{http.StatusUnauthorized}, 0, false, 2, 2},
+		{"session expired twice", []int{http.StatusUnauthorized, http.StatusUnauthorized}, 3, true, 2, 2},
+		{"gateway errors", []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout}, 3, false, 1, 4},
+		{"retries exhausted", []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable}, 1, true, 1, 2},
+		{"not retryable", []int{http.StatusBadRequest}, 3, true, 1, 1},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			stub := newStubController(t, c.failures...)
+
+			lc := &lazyClient{
+				baseURL:      stub.URL,
+				user:         "admin",
+				pass:         "admin",
+				maxRetries:   c.maxRetries,
+				retryBackoff: time.Millisecond,
+			}
+
+			_, err := lc.ListSites(context.Background())
+			switch {
+			case c.expectError && err == nil:
+				t.Fatal("expected an error")
+			case !c.expectError && err != nil:
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if stub.logins != c.expectedLogins {
+				t.Fatalf("expected %d logins, got %d", c.expectedLogins, stub.logins)
+			}
+			if stub.requests != c.expectedRequests {
+				t.Fatalf("expected %d requests, got %d", c.expectedRequests, stub.requests)
+			}
+		})
+	}
+}
+
+func TestLazyClient_maxConcurrentRequests(t *testing.T) {
+	stub := newStubController(t)
+	stub.latency = 20 * time.Millisecond
+
+	lc := &lazyClient{
+		baseURL:               stub.URL,
+		user:                  "admin",
+		pass:                  "admin",
+		maxConcurrentRequests: 4,
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 20)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := lc.ListSites(context.Background())
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if stub.maxInFlight < 2 || stub.maxInFlight > 4 {
+		t.Fatalf("expected between 2 and 4 requests in flight, got %d", stub.maxInFlight)
+	}
+}
+
+// BenchmarkLazyClient_concurrentRequests refreshes 50 objects in parallel against a stub
+// controller with 5ms of latency per request, to show the effect of the request limit.
+func BenchmarkLazyClient_concurrentRequests(b *testing.B) {
+	for _, maxConcurrentRequests := range []int{1, 4, 10, 50} {
+		b.Run(fmt.Sprintf("max_concurrent_requests=%d", maxConcurrentRequests), func(b *testing.B) {
+			log.SetOutput(io.Discard)
+			b.Cleanup(func() {
+				log.SetOutput(os.Stderr)
+			})
+
+			stub := newStubController(b)
+			stub.latency = 5 * time.Millisecond
+
+			lc := &lazyClient{
+				baseURL:               stub.URL,
+				user:                  "admin",
+				pass:                  "admin",
+				maxConcurrentRequests: maxConcurrentRequests,
+			}
+			if err := lc.init(context.Background()); err != nil {
+				b.Fatal(err)
+			}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				var wg sync.WaitGroup
+				for j := 0; j < 50; j++ {
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						if _, err := lc.ListSites(context.Background()); err != nil {
+							b.Error(err)
+						}
+					}()
+				}
+				wg.Wait()
+			}
+		})
+	}
+}
@@ -13,6 +13,10 @@
 
 var macAddressRegexp = regexp.MustCompile("^([0-9a-fA-F][0-9a-fA-F][-:]){5}([0-9a-fA-F][0-9a-fA-F])$")
 
+// the controller normalizes MACs, so sets of MACs only accept the normalized form, a
+// DiffSuppressFunc has no effect on set elements
+var normalizedMACRegexp = regexp.MustCompile("^([0-9a-f]{2}:){5}[0-9a-f]{2}$")
+
 func cleanMAC(mac string) string {
 	return strings.TrimSpace(strings.ReplaceAll(strings.ToLower(mac), "-", ":"))
 }
@@ -11,7 +11,10 @@ func markdownValueListInt(values []int) string {
 	default:
 		s := ""
 		for i := 0; i < len(values)-1; i++ {
-			s += "`" + strconv.Itoa(values[i]) + "`, "
+			if i > 0 {
+				s += ", "
+			}
+			s += "`" + strconv.Itoa(values[i]) + "`"
 		}
 		s += " and `" + strconv.Itoa(values[len(values)-1]) + "`"
 		return s
@@ -4,9 +4,11 @@
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/paultyng/go-unifi/unifi"
 )
 
@@ -31,18 +33,27 @@ func New(version string) func() *schema.Provider {
 			Schema: map[string]*schema.Schema{
 				"username": {
 					Description: "Local user name for the Unifi controller API. Can be specified with the `UNIFI_USERNAME` " +
-						"environment variable.",
+						"environment variable. Required unless `api_key` is set.",
 					Type:        schema.TypeString,
-					Required:    true,
+					Optional:    true,
 					DefaultFunc: schema.EnvDefaultFunc("UNIFI_USERNAME", ""),
 				},
 				"password": {
 					Description: "Password for the user accessing the API. Can be specified with the `UNIFI_PASSWORD` " +
-						"environment variable.",
+						"environment variable. Required unless `api_key` is set.",
 					Type:        schema.TypeString,
-					Required:    true,
+					Optional:    true,
+					Sensitive:   true,
 					DefaultFunc: schema.EnvDefaultFunc("UNIFI_PASSWORD", ""),
 				},
+				"api_key": {
+					Description: "API key for the controller API, only supported by UniFi OS consoles. Can be specified with " +
+						"the `UNIFI_API_KEY` environment variable. Mutually exclusive with `username` and `password`.",
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					DefaultFunc: schema.EnvDefaultFunc("UNIFI_API_KEY", ""),
+				},
 				"api_url": {
 					Description: "URL of the controller API. Can be specified with the `UNIFI_API` environment variable. " +
 						"You should **NOT** supply the path (`/api`), the SDK will discover the appropriate paths. This is " +
@@ -67,36 +78,90 @@ func New(version string) func() *schema.Provider {
 					Optional:    true,
 					DefaultFunc: schema.EnvDefaultFunc("UNIFI_INSECURE", false),
 				},
+				"max_retries": {
+					Description: "Maximum number of times a read, update or delete request is retried when the controller " +
+						"returns a gateway error (502, 503 or 504) or the connection fails. Expired sessions are always " +
+						"re-authenticated once regardless of this setting.",
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      3,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"retry_backoff": {
+					Description: "Initial delay between retries as a duration string (for example `500ms` or `2s`), " +
+						"doubled on each subsequent retry.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "1s",
+					ValidateFunc: validateDuration,
+				},
+				"max_concurrent_requests": {
+					Description: "Maximum number of requests sent to the controller at the same time. Raising Terraform's " +
+						"`-parallelism` only speeds up plans and applies up to this limit.",
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      10,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"cache_reads": {
+					Description: "Cache the lists of objects read from the controller for the duration of a plan, refresh " +
+						"or apply, so that reading many resources of the same type only lists them once per site. The cache " +
+						"for a type is cleared whenever the provider creates, updates or deletes an object of that type. " +
+						"Changes made outside of Terraform while the provider is running are not seen.",
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
 			},
 			DataSourcesMap: map[string]*schema.Resource{
 				"unifi_ap_group":       dataAPGroup(),
+				"unifi_devices":        dataDevices(),
 				"unifi_network":        dataNetwork(),
 				"unifi_port_profile":   dataPortProfile(),
 				"unifi_radius_profile": dataRADIUSProfile(),
 				"unifi_user_group":     dataUserGroup(),
 				"unifi_user":           dataUser(),
+				"unifi_users":          dataUsers(),
 				"unifi_account":        dataAccount(),
+				"unifi_wlan_group":     dataWLANGroup(),
 			},
 			ResourcesMap: map[string]*schema.Resource{
-				// TODO: "unifi_ap_group"
-				"unifi_device":         resourceDevice(),
-				"unifi_dynamic_dns":    resourceDynamicDNS(),
-				"unifi_firewall_group": resourceFirewallGroup(),
-				"unifi_firewall_rule":  resourceFirewallRule(),
-				"unifi_network":        resourceNetwork(),
-				"unifi_port_forward":   resourcePortForward(),
-				"unifi_port_profile":   resourcePortProfile(),
-				"unifi_radius_profile": resourceRadiusProfile(),
-				"unifi_site":           resourceSite(),
-				"unifi_static_route":   resourceStaticRoute(),
-				"unifi_user_group":     resourceUserGroup(),
-				"unifi_user":           resourceUser(),
-				"unifi_wlan":           resourceWLAN(),
-				"unifi_account":        resourceAccount(),
-
-				"unifi_setting_mgmt":   resourceSettingMgmt(),
-				"unifi_setting_radius": resourceSettingRadius(),
-				"unifi_setting_usg":    resourceSettingUsg(),
+				"unifi_ap_group":            resourceAPGroup(),
+				"unifi_device":              resourceDevice(),
+				"unifi_dhcp_option":         resourceDHCPOption(),
+				"unifi_dpi_app":             resourceDPIApp(),
+				"unifi_dpi_group":           resourceDPIGroup(),
+				"unifi_dynamic_dns":         resourceDynamicDNS(),
+				"unifi_firewall_group":      resourceFirewallGroup(),
+				"unifi_firewall_rule":       resourceFirewallRule(),
+				"unifi_firewall_rule_order": resourceFirewallRuleOrder(),
+				"unifi_hotspot_operator":    resourceHotspotOperator(),
+				"unifi_hotspot_package":     resourceHotspotPackage(),
+				"unifi_network":             resourceNetwork(),
+				"unifi_port_forward":        resourcePortForward(),
+				"unifi_port_profile":        resourcePortProfile(),
+				"unifi_radius_profile":      resourceRadiusProfile(),
+				"unifi_schedule_task":       resourceScheduleTask(),
+				"unifi_site":                resourceSite(),
+				"unifi_static_route":        resourceStaticRoute(),
+				"unifi_user_group":          resourceUserGroup(),
+				"unifi_user":                resourceUser(),
+				"unifi_wlan":                resourceWLAN(),
+				"unifi_wlan_group":          resourceWLANGroup(),
+				"unifi_account":             resourceAccount(),
+
+				"unifi_setting_auto_speedtest": resourceSettingAutoSpeedtest(),
+				"unifi_setting_global_ap":      resourceSettingGlobalAP(),
+				"unifi_setting_global_switch":  resourceSettingGlobalSwitch(),
+				"unifi_setting_guest_access":   resourceSettingGuestAccess(),
+				"unifi_setting_ips":            resourceSettingIPS(),
+				"unifi_setting_mgmt":           resourceSettingMgmt(),
+				"unifi_setting_ntp":            resourceSettingNtp(),
+				"unifi_setting_radius":         resourceSettingRadius(),
+				"unifi_setting_rsyslogd":       resourceSettingRsyslogd(),
+				"unifi_setting_snmp":           resourceSettingSnmp(),
+				"unifi_setting_super_mgmt":     resourceSettingSuperMgmt(),
+				"unifi_setting_usg":            resourceSettingUsg(),
 			},
 		}
 
@@ -109,17 +174,44 @@ func configure(version string, p *schema.Provider) schema.ConfigureContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		user := d.Get("username").(string)
 		pass := d.Get("password").(string)
+		apiKey := d.Get("api_key").(string)
 		baseURL := d.Get("api_url").(string)
 		site := d.Get("site").(string)
 		insecure := d.Get("allow_insecure").(bool)
+		maxRetries := d.Get("max_retries").(int)
+		maxConcurrentRequests := d.Get("max_concurrent_requests").(int)
+		cacheReads := d.Get("cache_reads").(bool)
+
+		retryBackoff, err := time.ParseDuration(d.Get("retry_backoff").(string))
+		if err != nil {
+			return nil, diag.Errorf("unable to parse retry_backoff: %s", err)
+		}
+
+		switch {
+		case apiKey != "" && (user != "" || pass != ""):
+			return nil, diag.Errorf("api_key cannot be used together with username and password")
+		case apiKey == "" && (user == "" || pass == ""):
+			return nil, diag.Errorf("either api_key or both username and password must be set")
+		}
+
+		var uc unifiClient = &lazyClient{
+			user:     user,
+			pass:     pass,
+			apiKey:   apiKey,
+			baseURL:  baseURL,
+			insecure: insecure,
+
+			maxRetries:   maxRetries,
+			retryBackoff: retryBackoff,
+
+			maxConcurrentRequests: maxConcurrentRequests,
+		}
+		if cacheReads {
+			uc = newCachingClient(uc)
+		}
 
 		c := &client{
-			c: &lazyClient{
-				user:     user,
-				pass:     pass,
-				baseURL:  baseURL,
-				insecure: insecure,
-			},
+			c:    uc,
 			site: site,
 		}
 
@@ -149,29 +241,42 @@ type unifiClient interface {
 	UpdateFirewallRule(ctx context.Context, site string, d *unifi.FirewallRule) (*unifi.FirewallRule, error)
 
 	ListWLANGroup(ctx context.Context, site string) ([]unifi.WLANGroup, error)
+	DeleteWLANGroup(ctx context.Context, site, id string) error
+	CreateWLANGroup(ctx context.Context, site string, d *unifi.WLANGroup) (*unifi.WLANGroup, error)
+	GetWLANGroup(ctx context.Context, site, id string) (*unifi.WLANGroup, error)
+	UpdateWLANGroup(ctx context.Context, site string, d *unifi.WLANGroup) (*unifi.WLANGroup, error)
 
 	ListAPGroup(ctx context.Context, site string) ([]unifi.APGroup, error)
+	DeleteAPGroup(ctx context.Context, site, id string) error
+	CreateAPGroup(ctx context.Context, site string, d *unifi.APGroup) (*unifi.APGroup, error)
+	GetAPGroup(ctx context.Context, site, id string) (*unifi.APGroup, error)
+	UpdateAPGroup(ctx context.Context, site string, d *unifi.APGroup) (*unifi.APGroup, error)
 
 	DeleteNetwork(ctx context.Context, site, id, name string) error
 	CreateNetwork(ctx context.Context, site string, d *unifi.Network) (*unifi.Network, error)
 	GetNetwork(ctx context.Context, site, id string) (*unifi.Network, error)
 	ListNetwork(ctx context.Context, site string) ([]unifi.Network, error)
 	UpdateNetwork(ctx context.Context, site string, d *unifi.Network) (*unifi.Network, error)
+	GetNetworkDHCPOptionValues(ctx context.Context, site, id string) (map[string]string, error)
+	UpdateNetworkDHCPOptionValues(ctx context.Context, site, id string, values map[string]string) error
 
 	DeleteWLAN(ctx context.Context, site, id string) error
 	CreateWLAN(ctx context.Context, site string, d *unifi.WLAN) (*unifi.WLAN, error)
 	GetWLAN(ctx context.Context, site, id string) (*unifi.WLAN, error)
 	UpdateWLAN(ctx context.Context, site string, d *unifi.WLAN) (*unifi.WLAN, error)
+	UpdateWLANFields(ctx context.Context, site string, d *unifi.WLAN, fields map[string]interface{}) (*unifi.WLAN, error)
 
 	GetDevice(ctx context.Context, site, id string) (*unifi.Device, error)
 	GetDeviceByMAC(ctx context.Context, site, mac string) (*unifi.Device, error)
 	CreateDevice(ctx context.Context, site string, d *unifi.Device) (*unifi.Device, error)
 	UpdateDevice(ctx context.Context, site string, d *unifi.Device) (*unifi.Device, error)
+	UpdateDeviceFields(ctx context.Context, site string, d *unifi.Device, fields map[string]interface{}) (*unifi.Device, error)
 	DeleteDevice(ctx context.Context, site, id string) error
 	ListDevice(ctx context.Context, site string) ([]unifi.Device, error)
 	AdoptDevice(ctx context.Context, site, mac string) error
 	ForgetDevice(ctx context.Context, site, mac string) error
 
+	ListUser(ctx context.Context, site string) ([]unifi.User, error)
 	GetUser(ctx context.Context, site, id string) (*unifi.User, error)
 	GetUserByMAC(ctx context.Context, site, mac string) (*unifi.User, error)
 	CreateUser(ctx context.Context, site string, d *unifi.User) (*unifi.User, error)
@@ -229,9 +334,95 @@ type unifiClient interface {
 
 	GetSettingRadius(ctx context.Context, id string) (*unifi.SettingRadius, error)
 	UpdateSettingRadius(ctx context.Context, site string, d *unifi.SettingRadius) (*unifi.SettingRadius, error)
+
+	GetSettingNtp(ctx context.Context, site string) (*unifi.SettingNtp, error)
+	UpdateSettingNtp(ctx context.Context, site string, d *unifi.SettingNtp) (*unifi.SettingNtp, error)
+
+	GetSettingRsyslogd(ctx context.Context, site string) (*unifi.SettingRsyslogd, error)
+	UpdateSettingRsyslogd(ctx context.Context, site string, d *unifi.SettingRsyslogd) (*unifi.SettingRsyslogd, error)
+
+	GetSettingSnmp(ctx context.Context, site string) (*unifi.SettingSnmp, error)
+	UpdateSettingSnmp(ctx context.Context, site string, d *unifi.SettingSnmp) (*unifi.SettingSnmp, error)
+
+	GetSettingGuestAccess(ctx context.Context, site string) (*unifi.SettingGuestAccess, error)
+	UpdateSettingGuestAccess(ctx context.Context, site string, d *unifi.SettingGuestAccess) (*unifi.SettingGuestAccess, error)
+	UpdateSettingGuestAccessFields(ctx context.Context, site string, d *unifi.SettingGuestAccess, fields map[string]interface{}) (*unifi.SettingGuestAccess, error)
+
+	ListHotspotOp(ctx context.Context, site string) ([]unifi.HotspotOp, error)
+	GetHotspotOp(ctx context.Context, site, id string) (*unifi.HotspotOp, error)
+	DeleteHotspotOp(ctx context.Context, site, id string) error
+	CreateHotspotOp(ctx context.Context, site string, d *unifi.HotspotOp) (*unifi.HotspotOp, error)
+	UpdateHotspotOp(ctx context.Context, site string, d *unifi.HotspotOp) (*unifi.HotspotOp, error)
+
+	ListHotspotPackage(ctx context.Context, site string) ([]unifi.HotspotPackage, error)
+	GetHotspotPackage(ctx context.Context, site, id string) (*unifi.HotspotPackage, error)
+	DeleteHotspotPackage(ctx context.Context, site, id string) error
+	CreateHotspotPackage(ctx context.Context, site string, d *unifi.HotspotPackage) (*unifi.HotspotPackage, error)
+	UpdateHotspotPackage(ctx context.Context, site string, d *unifi.HotspotPackage) (*unifi.HotspotPackage, error)
+	UpdateHotspotPackageFields(ctx context.Context, site string, d *unifi.HotspotPackage, fields map[string]interface{}) (*unifi.HotspotPackage, error)
+
+	ListDpiApp(ctx context.Context, site string) ([]unifi.DpiApp, error)
+	GetDpiApp(ctx context.Context, site, id string) (*unifi.DpiApp, error)
+	DeleteDpiApp(ctx context.Context, site, id string) error
+	CreateDpiApp(ctx context.Context, site string, d *unifi.DpiApp) (*unifi.DpiApp, error)
+	UpdateDpiApp(ctx context.Context, site string, d *unifi.DpiApp) (*unifi.DpiApp, error)
+	UpdateDpiAppFields(ctx context.Context, site string, d *unifi.DpiApp, fields map[string]interface{}) (*unifi.DpiApp, error)
+
+	ListDpiGroup(ctx context.Context, site string) ([]unifi.DpiGroup, error)
+	GetDpiGroup(ctx context.Context, site, id string) (*unifi.DpiGroup, error)
+	DeleteDpiGroup(ctx context.Context, site, id string) error
+	CreateDpiGroup(ctx context.Context, site string, d *unifi.DpiGroup) (*unifi.DpiGroup, error)
+	UpdateDpiGroup(ctx context.Context, site string, d *unifi.DpiGroup) (*unifi.DpiGroup, error)
+	UpdateDpiGroupFields(ctx context.Context, site string, d *unifi.DpiGroup, fields map[string]interface{}) (*unifi.DpiGroup, error)
+
+	GetSettingIps(ctx context.Context, site string) (*unifi.SettingIps, error)
+	UpdateSettingIps(ctx context.Context, site string, d *unifi.SettingIps) (*unifi.SettingIps, error)
+
+	GetSettingGlobalAp(ctx context.Context, site string) (*unifi.SettingGlobalAp, error)
+	UpdateSettingGlobalAp(ctx context.Context, site string, d *unifi.SettingGlobalAp) (*unifi.SettingGlobalAp, error)
+
+	GetSettingGlobalSwitch(ctx context.Context, site string) (*unifi.SettingGlobalSwitch, error)
+	UpdateSettingGlobalSwitch(ctx context.Context, site string, d *unifi.SettingGlobalSwitch) (*unifi.SettingGlobalSwitch, error)
+	UpdateSettingGlobalSwitchFields(ctx context.Context, site string, d *unifi.SettingGlobalSwitch, fields map[string]interface{}) (*unifi.SettingGlobalSwitch, error)
+
+	ListScheduleTask(ctx context.Context, site string) ([]unifi.ScheduleTask, error)
+	GetScheduleTask(ctx context.Context, site, id string) (*unifi.ScheduleTask, error)
+	DeleteScheduleTask(ctx context.Context, site, id string) error
+	CreateScheduleTask(ctx context.Context, site string, d *unifi.ScheduleTask) (*unifi.ScheduleTask, error)
+	UpdateScheduleTask(ctx context.Context, site string, d *unifi.ScheduleTask) (*unifi.ScheduleTask, error)
+
+	GetSettingAutoSpeedtest(ctx context.Context, site string) (*unifi.SettingAutoSpeedtest, error)
+	UpdateSettingAutoSpeedtest(ctx context.Context, site string, d *unifi.SettingAutoSpeedtest) (*unifi.SettingAutoSpeedtest, error)
+
+	ListDHCPOption(ctx context.Context, site string) ([]unifi.DHCPOption, error)
+	GetDHCPOption(ctx context.Context, site, id string) (*unifi.DHCPOption, error)
+	DeleteDHCPOption(ctx context.Context, site, id string) error
+	CreateDHCPOption(ctx context.Context, site string, d *unifi.DHCPOption) (*unifi.DHCPOption, error)
+	UpdateDHCPOption(ctx context.Context, site string, d *unifi.DHCPOption) (*unifi.DHCPOption, error)
+
+	GetSettingSuperMgmt(ctx context.Context, site string) (*unifi.SettingSuperMgmt, error)
+	UpdateSettingSuperMgmt(ctx context.Context, site string, d *unifi.SettingSuperMgmt) (*unifi.SettingSuperMgmt, error)
+	UpdateSettingSuperMgmtFields(ctx context.Context, site string, d *unifi.SettingSuperMgmt, fields map[string]interface{}) (*unifi.SettingSuperMgmt, error)
 }
 
 type client struct {
 	c    unifiClient
 	site string
 }
+
+func validateDuration(v interface{}, k string) (ws []string, errs []error) {
+	s, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return nil, []error{fmt.Errorf("expected %q to be a valid duration, got %q: %w", k, s, err)}
+	}
+	if d < 0 {
+		return nil, []error{fmt.Errorf("expected %q to not be negative, got %q", k, s)}
+	}
+
+	return nil, nil
+}
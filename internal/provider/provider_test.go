@@ -103,7 +103,7 @@ func runAcceptanceTests(m *testing.M) int {
 	}
 
 	testClient = &unifi.Client{}
-	setHTTPClient(testClient, true, "unifi")
+	setHTTPClient(testClient, true, "unifi", 0)
 	testClient.SetBaseURL(endpoint)
 	if err = testClient.Login(ctx, user, password); err != nil {
 		panic(err)
@@ -140,10 +140,11 @@ func siteAndIDImportStateIDFunc(resourceName string) func(*terraform.State) (str
 
 func preCheck(t *testing.T) {
 	variables := []string{
-		"UNIFI_USERNAME",
-		"UNIFI_PASSWORD",
 		"UNIFI_API",
 	}
+	if os.Getenv("UNIFI_API_KEY") == "" {
+		variables = append(variables, "UNIFI_USERNAME", "UNIFI_PASSWORD")
+	}
 
 	for _, variable := range variables {
 		value := os.Getenv(variable)
@@ -182,3 +183,33 @@ func getTestVLAN(t *testing.T) (*net.IPNet, int) {
 
 	return subnet, vlan
 }
+
+func TestProviderConfigure_credentials(t *testing.T) {
+	for _, c := range []struct {
+		name    string
+		config  map[string]interface{}
+		wantErr bool
+	}{
+		{"user and password", map[string]interface{}{"username": "admin", "password": "admin"}, false},
+		{"api key", map[string]interface{}{"api_key": "key"}, false},
+		{"api key and user", map[string]interface{}{"api_key": "key", "username": "admin"}, true},
+		{"api key and password", map[string]interface{}{"api_key": "key", "password": "admin"}, true},
+		{"user without password", map[string]interface{}{"username": "admin"}, true},
+		{"nothing", map[string]interface{}{}, true},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			for _, env := range []string{"UNIFI_USERNAME", "UNIFI_PASSWORD", "UNIFI_API_KEY"} {
+				t.Setenv(env, "")
+			}
+
+			c.config["api_url"] = "https://localhost:8443"
+
+			p := New("test")()
+			d := schema.TestResourceDataRaw(t, p.Schema, c.config)
+			_, diags := p.ConfigureContextFunc(context.Background(), d)
+			if c.wantErr != diags.HasError() {
+				t.Fatalf("expected error %t, got %v", c.wantErr, diags)
+			}
+		})
+	}
+}
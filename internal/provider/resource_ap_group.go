@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/paultyng/go-unifi/unifi"
+)
+
+func resourceAPGroup() *schema.Resource {
+	return &schema.Resource{
+		Description: "`unifi_ap_group` manages a group of access points which WLANs can be broadcast on.",
+
+		CreateContext: resourceAPGroupCreate,
+		ReadContext:   resourceAPGroupRead,
+		UpdateContext: resourceAPGroupUpdate,
+		DeleteContext: resourceAPGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importSiteAndID,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of the AP group.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"site": {
+				Description: "The name of the site to associate the AP group with.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description: "The name of the AP group.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"device_macs": {
+				Description: "The MAC addresses of the access points in the group, in lower case and separated by colons.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(normalizedMACRegexp, "Mac address must be lower case and colon separated"),
+				},
+			},
+		},
+	}
+}
+
+func resourceAPGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	req, err := resourceAPGroupGetResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+
+	resp, err := c.c.CreateAPGroup(ctx, site, req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resp.ID)
+
+	return resourceAPGroupSetResourceData(resp, d, site)
+}
+
+func resourceAPGroupGetResourceData(d *schema.ResourceData) (*unifi.APGroup, error) {
+	deviceMACs, err := setToStringSlice(d.Get("device_macs").(*schema.Set))
+	if err != nil {
+		return nil, err
+	}
+
+	return &unifi.APGroup{
+		Name:       d.Get("name").(string),
+		DeviceMACs: deviceMACs,
+	}, nil
+}
+
+func resourceAPGroupSetResourceData(resp *unifi.APGroup, d *schema.ResourceData, site string) diag.Diagnostics {
+	d.Set("site", site)
+	d.Set("name", resp.Name)
+	d.Set("device_macs", stringSliceToSet(resp.DeviceMACs))
+
+	return nil
+}
+
+func resourceAPGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	id := d.Id()
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+
+	resp, err := c.c.GetAPGroup(ctx, site, id)
+	if _, ok := err.(*unifi.NotFoundError); ok {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceAPGroupSetResourceData(resp, d, site)
+}
+
+func resourceAPGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	req, err := resourceAPGroupGetResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req.ID = d.Id()
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+
+	resp, err := c.c.UpdateAPGroup(ctx, site, req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceAPGroupSetResourceData(resp, d, site)
+}
+
+func resourceAPGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	id := d.Id()
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+	err := c.c.DeleteAPGroup(ctx, site, id)
+	if _, ok := err.(*unifi.NotFoundError); ok {
+		return nil
+	}
+	return diag.FromErr(err)
+}
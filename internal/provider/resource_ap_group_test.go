@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAPGroup_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { preCheck(t) },
+		ProviderFactories: providerFactories,
+		// TODO: CheckDestroy: ,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPGroupConfig("tfacc"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_ap_group.test", "name", "tfacc"),
+					resource.TestCheckResourceAttr("unifi_ap_group.test", "device_macs.#", "0"),
+				),
+			},
+			importStep("unifi_ap_group.test"),
+			{
+				Config: testAccAPGroupConfig("tfacc-renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_ap_group.test", "name", "tfacc-renamed"),
+				),
+			},
+			importStep("unifi_ap_group.test"),
+		},
+	})
+}
+
+func TestAccAPGroup_wlan(t *testing.T) {
+	subnet, vlan := getTestVLAN(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { preCheck(t) },
+		ProviderFactories: providerFactories,
+		// TODO: CheckDestroy: ,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPGroupConfig_wlan(subnet.String(), vlan),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("unifi_wlan.test", "ap_group_ids.0", "unifi_ap_group.test", "id"),
+				),
+			},
+			importStep("unifi_ap_group.test"),
+		},
+	})
+}
+
+func testAccAPGroupConfig(name string) string {
+	return fmt.Sprintf(`
+resource "unifi_ap_group" "test" {
+	name = %q
+}
+`, name)
+}
+
+func testAccAPGroupConfig_wlan(subnet string, vlan int) string {
+	return fmt.Sprintf(`
+data "unifi_user_group" "default" {
+}
+
+resource "unifi_network" "test" {
+	name    = "tfacc"
+	purpose = "corporate"
+
+	subnet  = %q
+	vlan_id = %d
+}
+
+resource "unifi_ap_group" "test" {
+	name = "tfacc"
+}
+
+resource "unifi_wlan" "test" {
+	name          = "tfacc-ap-group"
+	network_id    = unifi_network.test.id
+	passphrase    = "12345678"
+	ap_group_ids  = [unifi_ap_group.test.id]
+	user_group_id = data.unifi_user_group.default.id
+	security      = "wpapsk"
+}
+`, subnet, vlan)
+}
@@ -2,7 +2,10 @@
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -14,6 +17,12 @@
 	"github.com/paultyng/go-unifi/unifi"
 )
 
+var (
+	deviceRadioBands         = []string{"ng", "na", "6e"}
+	deviceRadioChannelRegexp = regexp.MustCompile(`^(auto|[0-9]{1,3})$`)
+	deviceLEDColorRegexp     = regexp.MustCompile(`^#([0-9a-fA-F]{3}){1,2}$`)
+)
+
 func resourceDevice() *schema.Resource {
 	return &schema.Resource{
 		Description: "`unifi_device` manages a device of the network.\n\n" +
@@ -28,6 +37,7 @@ func resourceDevice() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceDeviceImport,
 		},
+		CustomizeDiff: resourceDeviceCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -62,8 +72,108 @@ func resourceDevice() *schema.Resource {
 				Type:        schema.TypeBool,
 				Computed:    true,
 			},
+			"led_override": {
+				Description:  "Whether the LED of the device follows the site default (`default`) or is forced `on` or `off`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"default", "on", "off"}, false),
+			},
+			"led_override_color": {
+				Description:  "The color of the LED of devices that support it, as a hex color such as `#0000ff`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringMatch(deviceLEDColorRegexp, "must be a hex color such as #0000ff"),
+			},
+			"led_override_color_brightness": {
+				Description:  "The brightness of the LED of devices that support it, in percent.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+			"snmp_contact": {
+				Description:  "The SNMP contact of the device.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"snmp_location": {
+				Description:  "The SNMP location of the device.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"stp_version": {
+				Description:  "The spanning tree protocol version of the switch, valid values are `stp`, `rstp` and `disabled`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"stp", "rstp", "disabled"}, false),
+			},
+			"stp_priority": {
+				Description:  "The spanning tree bridge priority of the switch, a multiple of `4096` up to `61440`. The switch with the lowest priority becomes the root bridge.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.All(validation.IntBetween(0, 61440), validation.IntDivisibleBy(4096)),
+			},
+			"jumboframe_enabled": {
+				Description: "Whether jumbo frames are enabled on the switch.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"flowctrl_enabled": {
+				Description: "Whether flow control is enabled on the switch.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"dot1x_portctrl_enabled": {
+				Description: "Whether 802.1X port control is enabled on the switch.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"outlet_override": {
+				Description: "Settings overrides for specific outlets of PDUs and other devices with power outlets.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"index": {
+							Description:  "Outlet number.",
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"name": {
+							Description:  "Human-readable name of the outlet.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 128),
+						},
+						"relay_state": {
+							Description: "Whether the outlet is powered.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+						},
+						"cycle_enabled": {
+							Description: "Whether the outlet is power cycled when the connected device stops responding.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+						},
+					},
+				},
+			},
 			"port_override": {
-				Description: "Settings overrides for specific switch ports.",
+				Description: "Settings overrides for specific switch ports. Except for `name`, `port_profile_id`, `op_mode`, `poe_mode` and " +
+					"`aggregate_num_ports`, only the configured arguments are managed, the others keep the settings of the port profile.",
 				// TODO: this should really be a map or something when possible in the SDK
 				// see https://github.com/hashicorp/terraform-plugin-sdk/issues/62
 				Type:     schema.TypeSet,
@@ -116,6 +226,302 @@ func resourceDevice() *schema.Resource {
 								return false
 							},
 						},
+						"mirror_port_idx": {
+							Description:  "The number of the port whose traffic is mirrored to this port, requires `op_mode` to be `mirror`.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 52),
+						},
+						"forward": {
+							Description:  "The VLAN forwarding of the port, valid values are `all`, `native`, `customize` and `disabled`.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"all", "native", "customize", "disabled"}, false),
+						},
+						"native_network_id": {
+							Description: "The ID of the network used for untagged traffic on the port.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"tagged_network_ids": {
+							Description: "The IDs of the networks tagged on the port, requires `forward` to be `customize`.",
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"autoneg": {
+							Description: "Whether link auto negotiation is enabled. When `false`, `speed` and `full_duplex` are used instead.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+						},
+						"speed": {
+							Description:  "The link speed of the port when `autoneg` is `false`, valid values are `10`, `100`, `1000`, `2500`, `5000`, `10000`, `20000`, `25000`, `40000`, `50000` and `100000`.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntInSlice([]int{10, 100, 1000, 2500, 5000, 10000, 20000, 25000, 40000, 50000, 100000}),
+						},
+						"full_duplex": {
+							Description: "Whether the port is full duplex when `autoneg` is `false`.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+						},
+						"isolation": {
+							Description: "Whether the port is isolated from the other isolated ports of the switch.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+						},
+						"stp_port_mode": {
+							Description: "Whether spanning tree protocol is enabled on the port.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+						},
+						"lldpmed_enabled": {
+							Description: "Whether LLDP-MED is enabled on the port.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+						},
+						"lldpmed_notify_enabled": {
+							Description: "Whether LLDP-MED topology change notifications are enabled on the port.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+						},
+						"dot1x_ctrl": {
+							Description:  "The 802.1X control of the port, valid values are `auto`, `force_authorized`, `force_unauthorized`, `mac_based` and `multi_host`.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"auto", "force_authorized", "force_unauthorized", "mac_based", "multi_host"}, false),
+						},
+						"dot1x_idle_timeout": {
+							Description:  "The timeout in seconds of MAC based 802.1X control.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+						"egress_rate_limit_kbps_enabled": {
+							Description: "Whether egress rate limiting is enabled on the port.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+						},
+						"egress_rate_limit_kbps": {
+							Description:  "The egress rate limit of the port in kbps.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(64, 9999999),
+						},
+						"port_security_enabled": {
+							Description: "Whether port security is enabled, so only `port_security_mac_address` can use the port.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+						},
+						"port_security_mac_address": {
+							Description: "The MAC addresses allowed on the port when `port_security_enabled` is `true`.",
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringMatch(macAddressRegexp, "Mac address is invalid"),
+							},
+						},
+						"stormctrl_type": {
+							Description:  "The type of the storm control limits, valid values are `level` (a percentage of the link speed) and `rate` (packets per second).",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"level", "rate"}, false),
+						},
+						"stormctrl_bcast_enabled": {
+							Description: "Whether broadcast storm control is enabled on the port.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+						},
+						"stormctrl_bcast_level": {
+							Description:  "The broadcast storm control level when `stormctrl_type` is `level`.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						"stormctrl_bcast_rate": {
+							Description:  "The broadcast storm control rate when `stormctrl_type` is `rate`.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 14880000),
+						},
+						"stormctrl_mcast_enabled": {
+							Description: "Whether multicast storm control is enabled on the port.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+						},
+						"stormctrl_mcast_level": {
+							Description:  "The multicast storm control level when `stormctrl_type` is `level`.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						"stormctrl_mcast_rate": {
+							Description:  "The multicast storm control rate when `stormctrl_type` is `rate`.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 14880000),
+						},
+						"stormctrl_ucast_enabled": {
+							Description: "Whether unknown unicast storm control is enabled on the port.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+						},
+						"stormctrl_ucast_level": {
+							Description:  "The unknown unicast storm control level when `stormctrl_type` is `level`.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						"stormctrl_ucast_rate": {
+							Description:  "The unknown unicast storm control rate when `stormctrl_type` is `rate`.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 14880000),
+						},
+						"priority_queue1_level": {
+							Description:  "The level of priority queue 1 of the port.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						"priority_queue2_level": {
+							Description:  "The level of priority queue 2 of the port.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						"priority_queue3_level": {
+							Description:  "The level of priority queue 3 of the port.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						"priority_queue4_level": {
+							Description:  "The level of priority queue 4 of the port.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+					},
+				},
+			},
+			"management": {
+				Description: "The management interface of the device. Changing it re-provisions the device, which has to be " +
+					"able to reach the controller with the new settings.",
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Description:  "How the device is addressed, valid values are `dhcp` and `static`.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"dhcp", "static"}, false),
+						},
+						"ip": {
+							Description:  "The IPv4 address of the device, required for `static`.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsIPv4Address,
+						},
+						"netmask": {
+							Description:  "The netmask of the device, for example `255.255.255.0`, required for `static`.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsIPv4Address,
+						},
+						"gateway": {
+							Description:  "The IPv4 gateway of the device, required for `static`.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsIPv4Address,
+						},
+						"dns1": {
+							Description:  "The primary DNS server of the device, for `static`.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsIPAddress,
+						},
+						"dns2": {
+							Description:  "The secondary DNS server of the device, for `static`.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsIPAddress,
+						},
+						"dns_suffix": {
+							Description: "The DNS suffix of the device, for `static`.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"bonding_enabled": {
+							Description: "Whether the uplinks of the device are bonded.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+						},
+						"network_id": {
+							Description: "ID of the network (VLAN) the device is managed in.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"radio": {
+				Description: "Settings of the radios of an access point, by band. Only the configured arguments are managed, " +
+					"removing a block leaves the settings of that radio unchanged.",
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"band": {
+							Description:  "The band of the radio, valid values are `ng` (2.4 GHz), `na` (5 GHz) and `6e` (6 GHz).",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(deviceRadioBands, false),
+						},
+						"channel": {
+							Description:  "The channel of the radio, or `auto`.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringMatch(deviceRadioChannelRegexp, "must be a channel number or auto"),
+						},
+						"channel_width": {
+							Description:  "The channel width in MHz, valid values are `20`, `40`, `80` and `160`. The `ng` radio only supports `20` and `40`.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntInSlice([]int{20, 40, 80, 160}),
+						},
+						"tx_power_mode": {
+							Description:  "The transmit power of the radio, valid values are `auto`, `low`, `medium`, `high` and `custom`.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"auto", "low", "medium", "high", "custom"}, false),
+						},
+						"tx_power": {
+							Description:  "The transmit power in dBm when `tx_power_mode` is `custom`.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 49),
+						},
+						"min_rssi": {
+							Description:  "Disconnect clients with a signal weaker than this RSSI in dBm (-90 to -67). Use `0` to disable.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.Any(validation.IntInSlice([]int{0}), validation.IntBetween(-90, -67)),
+						},
+						"antenna_id": {
+							Description:  "The ID of the antenna, for models with selectable antennas.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 9),
+						},
+						"antenna_gain": {
+							Description: "The gain in dBi of an external antenna.",
+							Type:        schema.TypeInt,
+							Optional:    true,
+						},
 					},
 				},
 			},
@@ -172,6 +578,81 @@ func resourceDeviceImport(ctx context.Context, d *schema.ResourceData, meta inte
 	return []*schema.ResourceData{d}, nil
 }
 
+func resourceDeviceCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.NewValueKnown("port_override") {
+		pos, err := setToPortOverrides(diff.Get("port_override").(*schema.Set))
+		if err != nil {
+			return fmt.Errorf("unable to process port_override block: %w", err)
+		}
+
+		err = validatePortOverrides(pos)
+		if err != nil {
+			return err
+		}
+	}
+
+	if diff.NewValueKnown("radio") {
+		err := resourceDeviceValidateRadios(ctx, diff, meta)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resourceDeviceValidateRadios validates the radio blocks against the radios of the device model.
+func resourceDeviceValidateRadios(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	c := meta.(*client)
+
+	radios := diff.Get("radio").(*schema.Set)
+	if radios.Len() == 0 {
+		return nil
+	}
+
+	for _, item := range radios.List() {
+		data := item.(map[string]interface{})
+		if band, width := data["band"].(string), data["channel_width"].(int); band == "ng" && width > 40 {
+			return fmt.Errorf("channel_width %d is not supported by the ng radio", width)
+		}
+	}
+
+	site := diff.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+
+	var device *unifi.Device
+	var err error
+	switch mac := diff.Get("mac").(string); {
+	case diff.Id() != "":
+		device, err = c.c.GetDevice(ctx, site, diff.Id())
+	case mac != "":
+		device, err = c.c.GetDeviceByMAC(ctx, site, cleanMAC(mac))
+	default:
+		// the device is not known until it is imported
+		return nil
+	}
+	if _, ok := err.(*unifi.NotFoundError); ok {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	bands := map[string]bool{}
+	for _, r := range device.RadioTable {
+		bands[r.Radio] = true
+	}
+	for _, item := range radios.List() {
+		if band := item.(map[string]interface{})["band"].(string); !bands[band] {
+			return fmt.Errorf("device model %q has no %s radio", device.Model, band)
+		}
+	}
+
+	return nil
+}
+
 func resourceDeviceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c := meta.(*client)
 
@@ -231,13 +712,60 @@ func resourceDeviceUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 	req.ID = d.Id()
 	req.SiteID = site
 
-	resp, err := c.c.UpdateDevice(ctx, site, req)
+	// radios and outlets are merged in to the current settings of the device
+	var current *unifi.Device
+	radios := d.Get("radio").(*schema.Set)
+	outlets := d.Get("outlet_override").(*schema.Set)
+	if radios.Len() > 0 || outlets.Len() > 0 {
+		current, err = c.c.GetDevice(ctx, site, d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if radios.Len() > 0 {
+		req.RadioTable, err = setToRadioTable(radios, deviceRadioMinRssiBands(d), current)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("unable to process radio block: %w", err))
+		}
+	}
+
+	fields, err := resourceDeviceGetFields(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	fields["port_overrides"], err = portOverridesToFields(req.PortOverrides, deviceConfiguredPortOverrideFields(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("unable to process port_override block: %w", err))
+	}
+
+	if outlets.Len() > 0 {
+		fields["outlet_overrides"], err = setToOutletOverrides(outlets, current)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("unable to process outlet_override block: %w", err))
+		}
+	}
+
+	resp, err := c.c.UpdateDeviceFields(ctx, site, req, fields)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	_, err = waitForDeviceState(ctx, d, meta, unifi.DeviceStateConnected, []unifi.DeviceState{unifi.DeviceStateAdopting, unifi.DeviceStateProvisioning}, 1*time.Minute)
+	pendingStates := []unifi.DeviceState{unifi.DeviceStateAdopting, unifi.DeviceStateProvisioning}
+	timeout := 1 * time.Minute
+	managementChanged := d.HasChange("management")
+	if managementChanged {
+		// the device reconnects with its new address after it is re-provisioned
+		pendingStates = append(pendingStates, unifi.DeviceStateHeartbeatMissed, unifi.DeviceStatePending)
+		timeout = 5 * time.Minute
+	}
+
+	_, err = waitForDeviceState(ctx, d, meta, unifi.DeviceStateConnected, pendingStates, timeout)
 	if err != nil {
+		if managementChanged {
+			return diag.Errorf("device %s did not come back after changing its management settings, check it can reach the controller with the new settings: %s", d.Get("mac").(string), err)
+		}
 		return diag.FromErr(err)
 	}
 
@@ -294,7 +822,7 @@ func resourceDeviceRead(ctx context.Context, d *schema.ResourceData, meta interf
 }
 
 func resourceDeviceSetResourceData(resp *unifi.Device, d *schema.ResourceData, site string) diag.Diagnostics {
-	portOverrides, err := setFromPortOverrides(resp.PortOverrides)
+	portOverrides, err := setFromPortOverrides(resp.PortOverrides, d.Get("port_override").(*schema.Set))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -304,6 +832,23 @@ func resourceDeviceSetResourceData(resp *unifi.Device, d *schema.ResourceData, s
 	d.Set("name", resp.Name)
 	d.Set("disabled", resp.Disabled)
 	d.Set("port_override", portOverrides)
+	d.Set("led_override", resp.LedOverride)
+	d.Set("led_override_color", resp.LedOverrideColor)
+	d.Set("led_override_color_brightness", resp.LedOverrideColorBrightness)
+	d.Set("snmp_contact", resp.SnmpContact)
+	d.Set("snmp_location", resp.SnmpLocation)
+	d.Set("stp_version", resp.StpVersion)
+	if stpPriority, err := strconv.Atoi(resp.StpPriority); err == nil {
+		d.Set("stp_priority", stpPriority)
+	}
+	d.Set("jumboframe_enabled", resp.JumboframeEnabled)
+	d.Set("flowctrl_enabled", resp.FlowctrlEnabled)
+	d.Set("dot1x_portctrl_enabled", resp.Dot1XPortctrlEnabled)
+	d.Set("outlet_override", setFromOutletOverrides(resp.OutletOverrides, d.Get("outlet_override").(*schema.Set)))
+	if _, ok := d.GetOk("management.0"); ok {
+		d.Set("management", fromDeviceManagement(resp))
+	}
+	d.Set("radio", setFromRadioTable(resp.RadioTable, d.Get("radio").(*schema.Set)))
 
 	return nil
 }
@@ -316,11 +861,168 @@ func resourceDeviceGetResourceData(d *schema.ResourceData) (*unifi.Device, error
 
 	//TODO: pass Disabled once we figure out how to enable the device afterwards
 
-	return &unifi.Device{
+	device := &unifi.Device{
 		MAC:           d.Get("mac").(string),
 		Name:          d.Get("name").(string),
 		PortOverrides: pos,
-	}, nil
+	}
+
+	if v, ok := d.GetOk("management.0"); ok {
+		err = toDeviceManagement(v.(map[string]interface{}), device)
+		if err != nil {
+			return nil, fmt.Errorf("unable to process management block: %w", err)
+		}
+	}
+
+	return device, nil
+}
+
+// deviceFields maps the device arguments that are only sent when configured to their API names.
+var deviceFields = map[string]string{
+	"led_override":                  "led_override",
+	"led_override_color":            "led_override_color",
+	"led_override_color_brightness": "led_override_color_brightness",
+	"snmp_contact":                  "snmp_contact",
+	"snmp_location":                 "snmp_location",
+	"stp_version":                   "stp_version",
+	"stp_priority":                  "stp_priority",
+	"jumboframe_enabled":            "jumboframe_enabled",
+	"flowctrl_enabled":              "flowctrl_enabled",
+	"dot1x_portctrl_enabled":        "dot1x_portctrl_enabled",
+}
+
+// resourceDeviceGetFields returns the raw fields of the configured device arguments, so settings
+// managed elsewhere are not clobbered and false or empty values are still sent.
+func resourceDeviceGetFields(d *schema.ResourceData) (map[string]interface{}, error) {
+	config := d.GetRawConfig()
+
+	fields := map[string]interface{}{}
+	for k, apiKey := range deviceFields {
+		if config.GetAttr(k).IsNull() {
+			continue
+		}
+		v := d.Get(k)
+		if k == "stp_priority" {
+			v = strconv.Itoa(v.(int))
+		}
+		fields[apiKey] = v
+	}
+
+	return fields, nil
+}
+
+// setToOutletOverrides applies the outlet_override blocks to the outlet overrides of the device. They
+// are returned as raw fields, as relay_state and cycle_enabled need to be sent when false.
+func setToOutletOverrides(set *schema.Set, device *unifi.Device) ([]map[string]interface{}, error) {
+	// use a map here to remove any duplication
+	outletMap := map[int]map[string]interface{}{}
+	for _, o := range device.OutletOverrides {
+		outletMap[o.Index] = map[string]interface{}{
+			"index":         o.Index,
+			"name":          o.Name,
+			"relay_state":   o.RelayState,
+			"cycle_enabled": o.CycleEnabled,
+		}
+	}
+	for _, item := range set.List() {
+		data, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("unexpected data in block")
+		}
+		index := data["index"].(int)
+		outletMap[index] = map[string]interface{}{
+			"index":         index,
+			"name":          data["name"].(string),
+			"relay_state":   data["relay_state"].(bool),
+			"cycle_enabled": data["cycle_enabled"].(bool),
+		}
+	}
+
+	outlets := make([]map[string]interface{}, 0, len(outletMap))
+	for _, item := range outletMap {
+		outlets = append(outlets, item)
+	}
+	return outlets, nil
+}
+
+// setFromOutletOverrides returns the outlet_override blocks of the configured outlets.
+func setFromOutletOverrides(outlets []unifi.DeviceOutletOverrides, configured *schema.Set) []map[string]interface{} {
+	indexes := map[int]bool{}
+	for _, item := range configured.List() {
+		indexes[item.(map[string]interface{})["index"].(int)] = true
+	}
+
+	list := make([]map[string]interface{}, 0, configured.Len())
+	for _, o := range outlets {
+		if !indexes[o.Index] {
+			continue
+		}
+		list = append(list, map[string]interface{}{
+			"index":         o.Index,
+			"name":          o.Name,
+			"relay_state":   o.RelayState,
+			"cycle_enabled": o.CycleEnabled,
+		})
+	}
+	return list
+}
+
+func toDeviceManagement(data map[string]interface{}, device *unifi.Device) error {
+	cn := unifi.DeviceConfigNetwork{
+		Type:           data["type"].(string),
+		BondingEnabled: data["bonding_enabled"].(bool),
+	}
+
+	if cn.Type == "static" {
+		cn.IP = data["ip"].(string)
+		cn.Netmask = data["netmask"].(string)
+		cn.Gateway = data["gateway"].(string)
+		cn.DNS1 = data["dns1"].(string)
+		cn.DNS2 = data["dns2"].(string)
+		cn.DNSsuffix = data["dns_suffix"].(string)
+
+		if cn.IP == "" || cn.Netmask == "" || cn.Gateway == "" {
+			return fmt.Errorf("ip, netmask and gateway are required for static management")
+		}
+	} else {
+		for _, k := range []string{"ip", "netmask", "gateway", "dns1", "dns2", "dns_suffix"} {
+			if data[k].(string) != "" {
+				return fmt.Errorf("%s can only be set for static management", k)
+			}
+		}
+	}
+
+	device.ConfigNetwork = cn
+	device.MgmtNetworkID = data["network_id"].(string)
+
+	return nil
+}
+
+func fromDeviceManagement(device *unifi.Device) []interface{} {
+	cn := device.ConfigNetwork
+	v := map[string]interface{}{
+		"type":            cn.Type,
+		"ip":              "",
+		"netmask":         "",
+		"gateway":         "",
+		"dns1":            "",
+		"dns2":            "",
+		"dns_suffix":      "",
+		"bonding_enabled": cn.BondingEnabled,
+		"network_id":      device.MgmtNetworkID,
+	}
+
+	// the controller keeps the static settings when switching to dhcp
+	if cn.Type == "static" {
+		v["ip"] = cn.IP
+		v["netmask"] = cn.Netmask
+		v["gateway"] = cn.Gateway
+		v["dns1"] = cn.DNS1
+		v["dns2"] = cn.DNS2
+		v["dns_suffix"] = cn.DNSsuffix
+	}
+
+	return []interface{}{v}
 }
 
 func setToPortOverrides(set *schema.Set) ([]unifi.DevicePortOverrides, error) {
@@ -345,47 +1047,433 @@ func setToPortOverrides(set *schema.Set) ([]unifi.DevicePortOverrides, error) {
 	return pos, nil
 }
 
-func setFromPortOverrides(pos []unifi.DevicePortOverrides) ([]map[string]interface{}, error) {
+// setFromPortOverrides returns the port_override blocks of the port overrides. The arguments in portOverrideFields
+// are only read back when they are set in the configured blocks, so settings of the port profile do not cause diffs.
+func setFromPortOverrides(pos []unifi.DevicePortOverrides, configured *schema.Set) ([]map[string]interface{}, error) {
+	configuredMap := map[int]map[string]interface{}{}
+	for _, item := range configured.List() {
+		data := item.(map[string]interface{})
+		configuredMap[data["number"].(int)] = data
+	}
+
+	zeroValues, err := fromPortOverride(unifi.DevicePortOverrides{})
+	if err != nil {
+		return nil, err
+	}
+
 	list := make([]map[string]interface{}, 0, len(pos))
 	for _, po := range pos {
 		v, err := fromPortOverride(po)
 		if err != nil {
 			return nil, fmt.Errorf("unable to parse port override: %w", err)
 		}
+		for k := range portOverrideFields {
+			if portOverrideIsZero(configuredMap[po.PortIDX][k]) {
+				v[k] = zeroValues[k]
+			}
+		}
 		list = append(list, v)
 	}
 	return list, nil
 }
 
 func toPortOverride(data map[string]interface{}) (unifi.DevicePortOverrides, error) {
-	idx := data["number"].(int)
-	name := data["name"].(string)
-	profileID := data["port_profile_id"].(string)
-	opMode := data["op_mode"].(string)
-	poeMode := data["poe_mode"].(string)
-	aggregateNumPorts := data["aggregate_num_ports"].(int)
+	taggedNetworkIDs, err := setToStringSlice(data["tagged_network_ids"].(*schema.Set))
+	if err != nil {
+		return unifi.DevicePortOverrides{}, fmt.Errorf("unable to convert tagged_network_ids to string slice: %w", err)
+	}
+	macs, err := setToStringSlice(data["port_security_mac_address"].(*schema.Set))
+	if err != nil {
+		return unifi.DevicePortOverrides{}, fmt.Errorf("unable to convert port_security_mac_address to string slice: %w", err)
+	}
+	for i, mac := range macs {
+		macs[i] = cleanMAC(mac)
+	}
 
 	return unifi.DevicePortOverrides{
-		PortIDX:           idx,
-		Name:              name,
-		PortProfileID:     profileID,
-		OpMode:            opMode,
-		PoeMode:           poeMode,
-		AggregateNumPorts: aggregateNumPorts,
+		PortIDX:                      data["number"].(int),
+		Name:                         data["name"].(string),
+		PortProfileID:                data["port_profile_id"].(string),
+		OpMode:                       data["op_mode"].(string),
+		PoeMode:                      data["poe_mode"].(string),
+		AggregateNumPorts:            data["aggregate_num_ports"].(int),
+		MirrorPortIDX:                data["mirror_port_idx"].(int),
+		Forward:                      data["forward"].(string),
+		NativeNetworkID:              data["native_network_id"].(string),
+		TaggedNetworkIDs:             taggedNetworkIDs,
+		Autoneg:                      data["autoneg"].(bool),
+		Speed:                        data["speed"].(int),
+		FullDuplex:                   data["full_duplex"].(bool),
+		Isolation:                    data["isolation"].(bool),
+		StpPortMode:                  data["stp_port_mode"].(bool),
+		LldpmedEnabled:               data["lldpmed_enabled"].(bool),
+		LldpmedNotifyEnabled:         data["lldpmed_notify_enabled"].(bool),
+		Dot1XCtrl:                    data["dot1x_ctrl"].(string),
+		Dot1XIDleTimeout:             data["dot1x_idle_timeout"].(int),
+		EgressRateLimitKbpsEnabled:   data["egress_rate_limit_kbps_enabled"].(bool),
+		EgressRateLimitKbps:          data["egress_rate_limit_kbps"].(int),
+		PortSecurityEnabled:          data["port_security_enabled"].(bool),
+		PortSecurityMACAddress:       macs,
+		StormctrlType:                data["stormctrl_type"].(string),
+		StormctrlBroadcastastEnabled: data["stormctrl_bcast_enabled"].(bool),
+		StormctrlBroadcastastLevel:   data["stormctrl_bcast_level"].(int),
+		StormctrlBroadcastastRate:    data["stormctrl_bcast_rate"].(int),
+		StormctrlMcastEnabled:        data["stormctrl_mcast_enabled"].(bool),
+		StormctrlMcastLevel:          data["stormctrl_mcast_level"].(int),
+		StormctrlMcastRate:           data["stormctrl_mcast_rate"].(int),
+		StormctrlUcastEnabled:        data["stormctrl_ucast_enabled"].(bool),
+		StormctrlUcastLevel:          data["stormctrl_ucast_level"].(int),
+		StormctrlUcastRate:           data["stormctrl_ucast_rate"].(int),
+		PriorityQueue1Level:          data["priority_queue1_level"].(int),
+		PriorityQueue2Level:          data["priority_queue2_level"].(int),
+		PriorityQueue3Level:          data["priority_queue3_level"].(int),
+		PriorityQueue4Level:          data["priority_queue4_level"].(int),
 	}, nil
 }
 
 func fromPortOverride(po unifi.DevicePortOverrides) (map[string]interface{}, error) {
 	return map[string]interface{}{
-		"number":              po.PortIDX,
-		"name":                po.Name,
-		"port_profile_id":     po.PortProfileID,
-		"op_mode":             po.OpMode,
-		"poe_mode":            po.PoeMode,
-		"aggregate_num_ports": po.AggregateNumPorts,
+		"number":                         po.PortIDX,
+		"name":                           po.Name,
+		"port_profile_id":                po.PortProfileID,
+		"op_mode":                        po.OpMode,
+		"poe_mode":                       po.PoeMode,
+		"aggregate_num_ports":            po.AggregateNumPorts,
+		"mirror_port_idx":                po.MirrorPortIDX,
+		"forward":                        po.Forward,
+		"native_network_id":              po.NativeNetworkID,
+		"tagged_network_ids":             stringSliceToSet(po.TaggedNetworkIDs),
+		"autoneg":                        po.Autoneg,
+		"speed":                          po.Speed,
+		"full_duplex":                    po.FullDuplex,
+		"isolation":                      po.Isolation,
+		"stp_port_mode":                  po.StpPortMode,
+		"lldpmed_enabled":                po.LldpmedEnabled,
+		"lldpmed_notify_enabled":         po.LldpmedNotifyEnabled,
+		"dot1x_ctrl":                     po.Dot1XCtrl,
+		"dot1x_idle_timeout":             po.Dot1XIDleTimeout,
+		"egress_rate_limit_kbps_enabled": po.EgressRateLimitKbpsEnabled,
+		"egress_rate_limit_kbps":         po.EgressRateLimitKbps,
+		"port_security_enabled":          po.PortSecurityEnabled,
+		"port_security_mac_address":      stringSliceToSet(po.PortSecurityMACAddress),
+		"stormctrl_type":                 po.StormctrlType,
+		"stormctrl_bcast_enabled":        po.StormctrlBroadcastastEnabled,
+		"stormctrl_bcast_level":          po.StormctrlBroadcastastLevel,
+		"stormctrl_bcast_rate":           po.StormctrlBroadcastastRate,
+		"stormctrl_mcast_enabled":        po.StormctrlMcastEnabled,
+		"stormctrl_mcast_level":          po.StormctrlMcastLevel,
+		"stormctrl_mcast_rate":           po.StormctrlMcastRate,
+		"stormctrl_ucast_enabled":        po.StormctrlUcastEnabled,
+		"stormctrl_ucast_level":          po.StormctrlUcastLevel,
+		"stormctrl_ucast_rate":           po.StormctrlUcastRate,
+		"priority_queue1_level":          po.PriorityQueue1Level,
+		"priority_queue2_level":          po.PriorityQueue2Level,
+		"priority_queue3_level":          po.PriorityQueue3Level,
+		"priority_queue4_level":          po.PriorityQueue4Level,
 	}, nil
 }
 
+// portOverrideFields maps the port_override arguments that are only sent when configured to their JSON names.
+var portOverrideFields = map[string]string{
+	"mirror_port_idx":                "mirror_port_idx",
+	"forward":                        "forward",
+	"native_network_id":              "native_networkconf_id",
+	"tagged_network_ids":             "tagged_networkconf_ids",
+	"autoneg":                        "autoneg",
+	"speed":                          "speed",
+	"full_duplex":                    "full_duplex",
+	"isolation":                      "isolation",
+	"stp_port_mode":                  "stp_port_mode",
+	"lldpmed_enabled":                "lldpmed_enabled",
+	"lldpmed_notify_enabled":         "lldpmed_notify_enabled",
+	"dot1x_ctrl":                     "dot1x_ctrl",
+	"dot1x_idle_timeout":             "dot1x_idle_timeout",
+	"egress_rate_limit_kbps_enabled": "egress_rate_limit_kbps_enabled",
+	"egress_rate_limit_kbps":         "egress_rate_limit_kbps",
+	"port_security_enabled":          "port_security_enabled",
+	"port_security_mac_address":      "port_security_mac_address",
+	"stormctrl_type":                 "stormctrl_type",
+	"stormctrl_bcast_enabled":        "stormctrl_bcast_enabled",
+	"stormctrl_bcast_level":          "stormctrl_bcast_level",
+	"stormctrl_bcast_rate":           "stormctrl_bcast_rate",
+	"stormctrl_mcast_enabled":        "stormctrl_mcast_enabled",
+	"stormctrl_mcast_level":          "stormctrl_mcast_level",
+	"stormctrl_mcast_rate":           "stormctrl_mcast_rate",
+	"stormctrl_ucast_enabled":        "stormctrl_ucast_enabled",
+	"stormctrl_ucast_level":          "stormctrl_ucast_level",
+	"stormctrl_ucast_rate":           "stormctrl_ucast_rate",
+	"priority_queue1_level":          "priority_queue1_level",
+	"priority_queue2_level":          "priority_queue2_level",
+	"priority_queue3_level":          "priority_queue3_level",
+	"priority_queue4_level":          "priority_queue4_level",
+}
+
+// portOverrideIsZero returns whether the value of a port_override argument is unset or its zero value.
+func portOverrideIsZero(v interface{}) bool {
+	switch v := v.(type) {
+	case bool:
+		return !v
+	case int:
+		return v == 0
+	case string:
+		return v == ""
+	case *schema.Set:
+		return v.Len() == 0
+	}
+	return v == nil
+}
+
+// deviceConfiguredPortOverrideFields returns the port_override arguments of portOverrideFields that are set in
+// the configuration, by port number.
+func deviceConfiguredPortOverrideFields(d *schema.ResourceData) map[int]map[string]bool {
+	configured := map[int]map[string]bool{}
+
+	overrides := d.GetRawConfig().GetAttr("port_override")
+	if overrides.IsNull() || !overrides.IsKnown() {
+		return configured
+	}
+	for it := overrides.ElementIterator(); it.Next(); {
+		_, override := it.Element()
+		number := override.GetAttr("number")
+		if !number.IsKnown() || number.IsNull() {
+			continue
+		}
+		idx, _ := number.AsBigFloat().Int64()
+
+		fields := map[string]bool{}
+		for k := range portOverrideFields {
+			if !override.GetAttr(k).IsNull() {
+				fields[k] = true
+			}
+		}
+		configured[int(idx)] = fields
+	}
+
+	return configured
+}
+
+// portOverridesToFields returns the port overrides as raw fields. The arguments in portOverrideFields are only
+// sent when configured, and then also when false or 0, so unset arguments keep the settings of the port profile.
+func portOverridesToFields(pos []unifi.DevicePortOverrides, configured map[int]map[string]bool) ([]map[string]interface{}, error) {
+	zeroValues, err := fromPortOverride(unifi.DevicePortOverrides{})
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]map[string]interface{}, 0, len(pos))
+	for _, po := range pos {
+		b, err := json.Marshal(po)
+		if err != nil {
+			return nil, err
+		}
+		v := map[string]interface{}{}
+		err = json.Unmarshal(b, &v)
+		if err != nil {
+			return nil, err
+		}
+
+		for k, apiKey := range portOverrideFields {
+			if !configured[po.PortIDX][k] {
+				delete(v, apiKey)
+				continue
+			}
+			if _, ok := v[apiKey]; ok {
+				continue
+			}
+			// send the empty values omitted by the struct
+			switch zero := zeroValues[k].(type) {
+			case *schema.Set:
+				v[apiKey] = []string{}
+			default:
+				v[apiKey] = zero
+			}
+		}
+		list = append(list, v)
+	}
+	return list, nil
+}
+
+// validatePortOverrides checks the op_mode specific settings of the port_override blocks and that
+// aggregated ports are not also part of another aggregate or a mirror.
+func validatePortOverrides(pos []unifi.DevicePortOverrides) error {
+	sort.Slice(pos, func(i, j int) bool { return pos[i].PortIDX < pos[j].PortIDX })
+
+	// aggregates maps every port of an aggregate to the first port of the aggregate
+	aggregates := map[int]int{}
+	for _, po := range pos {
+		if len(po.TaggedNetworkIDs) > 0 && po.Forward != "customize" {
+			return fmt.Errorf("tagged_network_ids of port %d requires forward customize", po.PortIDX)
+		}
+		if po.OpMode != "aggregate" {
+			if po.AggregateNumPorts != 0 {
+				return fmt.Errorf("aggregate_num_ports of port %d requires op_mode aggregate", po.PortIDX)
+			}
+			continue
+		}
+		if po.AggregateNumPorts == 0 {
+			return fmt.Errorf("port %d requires aggregate_num_ports when op_mode is aggregate", po.PortIDX)
+		}
+		for idx := po.PortIDX; idx < po.PortIDX+po.AggregateNumPorts; idx++ {
+			if first, ok := aggregates[idx]; ok {
+				return fmt.Errorf("aggregate of port %d overlaps the aggregate of port %d on port %d", po.PortIDX, first, idx)
+			}
+			aggregates[idx] = po.PortIDX
+		}
+	}
+
+	for _, po := range pos {
+		if po.OpMode != "mirror" {
+			if po.MirrorPortIDX != 0 {
+				return fmt.Errorf("mirror_port_idx of port %d requires op_mode mirror", po.PortIDX)
+			}
+			continue
+		}
+		if po.MirrorPortIDX == 0 {
+			return fmt.Errorf("port %d requires mirror_port_idx when op_mode is mirror", po.PortIDX)
+		}
+		if po.MirrorPortIDX == po.PortIDX {
+			return fmt.Errorf("port %d cannot mirror itself", po.PortIDX)
+		}
+		if first, ok := aggregates[po.PortIDX]; ok {
+			return fmt.Errorf("mirror port %d is part of the aggregate of port %d", po.PortIDX, first)
+		}
+		if first, ok := aggregates[po.MirrorPortIDX]; ok {
+			return fmt.Errorf("port %d mirrors port %d, which is part of the aggregate of port %d", po.PortIDX, po.MirrorPortIDX, first)
+		}
+	}
+
+	return nil
+}
+
+// deviceRadioMinRssiBands returns the bands of the radio blocks that configure min_rssi, as 0 both disables it
+// and is the value of an unset argument.
+func deviceRadioMinRssiBands(d *schema.ResourceData) map[string]bool {
+	bands := map[string]bool{}
+
+	radios := d.GetRawConfig().GetAttr("radio")
+	if radios.IsNull() || !radios.IsKnown() {
+		return bands
+	}
+	for it := radios.ElementIterator(); it.Next(); {
+		_, radio := it.Element()
+		band := radio.GetAttr("band")
+		if !band.IsKnown() || band.IsNull() || radio.GetAttr("min_rssi").IsNull() {
+			continue
+		}
+		bands[band.AsString()] = true
+	}
+
+	return bands
+}
+
+// setToRadioTable applies the radio blocks to the radio table of the device, radios and arguments
+// that are not configured keep their current settings. min_rssi is only applied for the bands in minRssiBands.
+func setToRadioTable(set *schema.Set, minRssiBands map[string]bool, device *unifi.Device) ([]unifi.DeviceRadioTable, error) {
+	radios := append([]unifi.DeviceRadioTable{}, device.RadioTable...)
+
+	for _, item := range set.List() {
+		data, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("unexpected data in block")
+		}
+		band := data["band"].(string)
+
+		i := -1
+		for j, r := range radios {
+			if r.Radio == band {
+				i = j
+				break
+			}
+		}
+		if i < 0 {
+			return nil, fmt.Errorf("device model %q has no %s radio", device.Model, band)
+		}
+
+		r := &radios[i]
+		if v := data["channel"].(string); v != "" {
+			r.Channel = v
+		}
+		if v := data["channel_width"].(int); v != 0 {
+			r.Ht = v
+		}
+		if v := data["tx_power_mode"].(string); v != "" {
+			r.TxPowerMode = v
+		}
+		if v := data["tx_power"].(int); v != 0 {
+			if r.TxPowerMode != "custom" {
+				return nil, fmt.Errorf("tx_power of the %s radio can only be set when tx_power_mode is custom", band)
+			}
+			r.TxPower = strconv.Itoa(v)
+		}
+		if v := data["antenna_id"].(int); v != 0 {
+			r.AntennaID = v
+		}
+		if v := data["antenna_gain"].(int); v != 0 {
+			r.AntennaGain = v
+		}
+		if minRssiBands[band] {
+			r.MinRssi = data["min_rssi"].(int)
+			r.MinRssiEnabled = r.MinRssi != 0
+		}
+	}
+
+	return radios, nil
+}
+
+// setFromRadioTable returns the radio blocks of the configured bands, only reading back the configured
+// arguments so settings left to the controller do not cause diffs.
+func setFromRadioTable(radios []unifi.DeviceRadioTable, configured *schema.Set) []map[string]interface{} {
+	list := make([]map[string]interface{}, 0, configured.Len())
+	for _, item := range configured.List() {
+		data := item.(map[string]interface{})
+		band := data["band"].(string)
+
+		for _, r := range radios {
+			if r.Radio != band {
+				continue
+			}
+
+			v := map[string]interface{}{
+				"band":          band,
+				"channel":       "",
+				"channel_width": 0,
+				"tx_power_mode": "",
+				"tx_power":      0,
+				"min_rssi":      0,
+				"antenna_id":    0,
+				"antenna_gain":  0,
+			}
+			if data["channel"].(string) != "" {
+				v["channel"] = r.Channel
+			}
+			if data["channel_width"].(int) != 0 {
+				v["channel_width"] = r.Ht
+			}
+			if data["tx_power_mode"].(string) != "" {
+				v["tx_power_mode"] = r.TxPowerMode
+			}
+			if data["tx_power"].(int) != 0 {
+				// the controller reports auto for modes other than custom
+				if txPower, err := strconv.Atoi(r.TxPower); err == nil {
+					v["tx_power"] = txPower
+				}
+			}
+			if data["min_rssi"].(int) != 0 && r.MinRssiEnabled {
+				v["min_rssi"] = r.MinRssi
+			}
+			if data["antenna_id"].(int) != 0 {
+				v["antenna_id"] = r.AntennaID
+			}
+			if data["antenna_gain"].(int) != 0 {
+				v["antenna_gain"] = r.AntennaGain
+			}
+			list = append(list, v)
+			break
+		}
+	}
+	return list
+}
+
 func waitForDeviceState(ctx context.Context, d *schema.ResourceData, meta interface{}, targetState unifi.DeviceState, pendingStates []unifi.DeviceState, timeout time.Duration) (*unifi.Device, error) {
 	c := meta.(*client)
 
@@ -95,6 +95,80 @@ func allocateDevice(t *testing.T) (*unifi.Device, func()) {
 	return device, unallocate
 }
 
+var (
+	accessPointInit sync.Once
+	accessPointPool mapset.Set[*unifi.Device] = mapset.NewSet[*unifi.Device]()
+)
+
+// allocateAccessPoint allocates a demo access point with both a 2.4 GHz and a 5 GHz radio.
+func allocateAccessPoint(t *testing.T) (*unifi.Device, func()) {
+	ctx := context.Background()
+
+	accessPointInit.Do(func() {
+		// The demo devices don't appear instantly when the controller starts.
+		err := retry.RetryContext(ctx, 1*time.Minute, func() *retry.RetryError {
+			devices, err := testClient.ListDevice(ctx, "default")
+			if err != nil {
+				return retry.NonRetryableError(fmt.Errorf("Error listing devices: %w", err))
+			}
+
+			if len(devices) == 0 {
+				return retry.RetryableError(fmt.Errorf("No devices found"))
+			}
+
+			for _, device := range devices {
+				if device.Type != "uap" {
+					continue
+				}
+
+				bands := mapset.NewSet[string]()
+				for _, r := range device.RadioTable {
+					bands.Add(r.Radio)
+				}
+				if !bands.Contains("ng", "na") {
+					continue
+				}
+
+				d := device
+				if ok := accessPointPool.Add(&d); !ok {
+					return retry.NonRetryableError(fmt.Errorf("Failed to add access point to pool"))
+				}
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	var device *unifi.Device
+
+	err := retry.RetryContext(ctx, 1*time.Minute, func() *retry.RetryError {
+		var ok bool
+		device, ok = accessPointPool.Pop()
+
+		if device == nil || !ok {
+			return retry.RetryableError(fmt.Errorf("Unable to allocate test access point"))
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	unallocate := func() {
+		if ok := accessPointPool.Add(device); !ok {
+			t.Fatal("Failed to add access point to pool")
+		}
+	}
+
+	return device, unallocate
+}
+
 func isBroadcomSwitch(device unifi.Device) bool {
 	if device.Type != "usw" {
 		return false
@@ -298,6 +372,445 @@ func TestAccDevice_switch_portOverrides(t *testing.T) {
 	})
 }
 
+func TestAccDevice_switch_portOverrides_settings(t *testing.T) {
+	resourceName := "unifi_device.test"
+	site := "default"
+
+	device, unallocateDevice := allocateDevice(t)
+	defer unallocateDevice()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			preCheck(t)
+			preCheckDeviceExists(t, site, device.MAC)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckDeviceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeviceConfig_withPortOverrideSettings(device.MAC),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeviceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "port_override.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "port_override.*", map[string]string{
+						"number":                         "1",
+						"forward":                        "customize",
+						"isolation":                      "true",
+						"port_security_enabled":          "true",
+						"port_security_mac_address.#":    "1",
+						"egress_rate_limit_kbps_enabled": "true",
+						"egress_rate_limit_kbps":         "10000",
+						"stormctrl_type":                 "level",
+						"stormctrl_bcast_enabled":        "true",
+						"stormctrl_bcast_level":          "10",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "port_override.*", map[string]string{
+						"number":      "2",
+						"autoneg":     "false",
+						"speed":       "100",
+						"full_duplex": "true",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "port_override.*", map[string]string{
+						"number":          "3",
+						"op_mode":         "mirror",
+						"mirror_port_idx": "1",
+					}),
+				),
+			},
+			// the settings are not read back on import, as they cannot be told apart from the port profile settings
+			importStep(resourceName, "allow_adoption", "forget_on_destroy", "port_override"),
+			{
+				// unset arguments are left unchanged
+				Config: testAccDeviceConfig_withSettings(device.MAC, `
+	port_override {
+		number = 2
+		name   = "uplink"
+	}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "port_override.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "port_override.0.autoneg", "false"),
+					testAccCheckDevicePortOverrideAutoneg(site, device.MAC, 2, false),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDevice_switch_portOverrides_invalid(t *testing.T) {
+	mac := "00:00:5e:00:53:01"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { preCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeviceConfig_withSettings(mac, `
+	port_override {
+		number              = 1
+		op_mode             = "aggregate"
+		aggregate_num_ports = 4
+	}
+
+	port_override {
+		number              = 3
+		op_mode             = "aggregate"
+		aggregate_num_ports = 2
+	}
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("aggregate of port 3 overlaps the aggregate of port 1 on port 3"),
+			},
+			{
+				Config: testAccDeviceConfig_withSettings(mac, `
+	port_override {
+		number              = 1
+		op_mode             = "aggregate"
+		aggregate_num_ports = 2
+	}
+
+	port_override {
+		number          = 2
+		op_mode         = "mirror"
+		mirror_port_idx = 5
+	}
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("mirror port 2 is part of the aggregate of port 1"),
+			},
+			{
+				Config: testAccDeviceConfig_withSettings(mac, `
+	port_override {
+		number              = 1
+		op_mode             = "aggregate"
+		aggregate_num_ports = 2
+	}
+
+	port_override {
+		number          = 5
+		op_mode         = "mirror"
+		mirror_port_idx = 2
+	}
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("port 5 mirrors port 2, which is part of the aggregate of port 1"),
+			},
+			{
+				Config: testAccDeviceConfig_withSettings(mac, `
+	port_override {
+		number          = 5
+		mirror_port_idx = 2
+	}
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("mirror_port_idx of port 5 requires op_mode mirror"),
+			},
+		},
+	})
+}
+
+func TestAccDevice_accessPoint_radio(t *testing.T) {
+	resourceName := "unifi_device.test"
+	site := "default"
+
+	device, unallocateDevice := allocateAccessPoint(t)
+	defer unallocateDevice()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			preCheck(t)
+			preCheckDeviceExists(t, site, device.MAC)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckDeviceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeviceConfig_withRadios(device.MAC, `
+	radio {
+		band          = "ng"
+		channel       = "6"
+		channel_width = 20
+		tx_power_mode = "low"
+	}
+
+	radio {
+		band          = "na"
+		channel       = "auto"
+		channel_width = 80
+		tx_power_mode = "custom"
+		tx_power      = 17
+		min_rssi      = -80
+	}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeviceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "radio.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "radio.*", map[string]string{
+						"band":          "ng",
+						"channel":       "6",
+						"channel_width": "20",
+						"tx_power_mode": "low",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "radio.*", map[string]string{
+						"band":          "na",
+						"tx_power_mode": "custom",
+						"tx_power":      "17",
+						"min_rssi":      "-80",
+					}),
+				),
+			},
+			{
+				Config: testAccDeviceConfig_withRadios(device.MAC, `
+	radio {
+		band     = "na"
+		min_rssi = 0
+	}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "radio.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "radio.0.min_rssi", "0"),
+				),
+			},
+			{
+				Config: testAccDeviceConfig_withRadios(device.MAC, `
+	radio {
+		band     = "na"
+		min_rssi = -75
+	}
+`),
+				Check: resource.TestCheckResourceAttr(resourceName, "radio.0.min_rssi", "-75"),
+			},
+			{
+				// min_rssi is left unchanged when it is not configured
+				Config: testAccDeviceConfig_withRadios(device.MAC, `
+	radio {
+		band    = "na"
+		channel = "auto"
+	}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "radio.0.min_rssi", "0"),
+					testAccCheckDeviceRadioMinRssi(site, device.MAC, "na", -75),
+				),
+			},
+			{
+				Config: testAccDeviceConfig_withRadios(device.MAC, `
+	radio {
+		band          = "ng"
+		channel_width = 80
+	}
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("channel_width 80 is not supported by the ng radio"),
+			},
+		},
+	})
+}
+
+func testAccCheckDevicePortOverrideAutoneg(site, mac string, number int, autoneg bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		device, err := testClient.GetDeviceByMAC(context.Background(), site, mac)
+		if err != nil {
+			return err
+		}
+
+		for _, po := range device.PortOverrides {
+			if po.PortIDX != number {
+				continue
+			}
+			if po.Autoneg != autoneg {
+				return fmt.Errorf("expected autoneg %t on port %d, got %t", autoneg, number, po.Autoneg)
+			}
+			return nil
+		}
+
+		return fmt.Errorf("device %s has no override for port %d", mac, number)
+	}
+}
+
+func testAccCheckDeviceRadioMinRssi(site, mac, band string, minRssi int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		device, err := testClient.GetDeviceByMAC(context.Background(), site, mac)
+		if err != nil {
+			return err
+		}
+
+		for _, r := range device.RadioTable {
+			if r.Radio != band {
+				continue
+			}
+			if !r.MinRssiEnabled || r.MinRssi != minRssi {
+				return fmt.Errorf("expected min_rssi %d on the %s radio, got %d (enabled %t)", minRssi, band, r.MinRssi, r.MinRssiEnabled)
+			}
+			return nil
+		}
+
+		return fmt.Errorf("device %s has no %s radio", mac, band)
+	}
+}
+
+func TestAccDevice_switch_management(t *testing.T) {
+	resourceName := "unifi_device.test"
+	site := "default"
+
+	device, unallocateDevice := allocateDevice(t)
+	defer unallocateDevice()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			preCheck(t)
+			preCheckDeviceExists(t, site, device.MAC)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckDeviceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeviceConfig_withManagement(device.MAC, `
+		type       = "static"
+		ip         = "192.168.1.250"
+		netmask    = "255.255.255.0"
+		gateway    = "192.168.1.1"
+		dns1       = "192.168.1.1"
+		dns_suffix = "example.com"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeviceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "management.0.type", "static"),
+					resource.TestCheckResourceAttr(resourceName, "management.0.ip", "192.168.1.250"),
+					resource.TestCheckResourceAttr(resourceName, "management.0.dns_suffix", "example.com"),
+					resource.TestCheckResourceAttrPair(resourceName, "management.0.network_id", "data.unifi_network.default", "id"),
+				),
+			},
+			{
+				Config: testAccDeviceConfig_withManagement(device.MAC, `
+		type = "dhcp"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "management.0.type", "dhcp"),
+					resource.TestCheckResourceAttr(resourceName, "management.0.ip", ""),
+				),
+			},
+			{
+				Config: testAccDeviceConfig_withManagement(device.MAC, `
+		type = "static"
+		ip   = "192.168.1.250"
+`),
+				ExpectError: regexp.MustCompile("ip, netmask and gateway are required for static management"),
+			},
+		},
+	})
+}
+
+func TestAccDevice_switch_settings(t *testing.T) {
+	resourceName := "unifi_device.test"
+	site := "default"
+
+	device, unallocateDevice := allocateDevice(t)
+	defer unallocateDevice()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			preCheck(t)
+			preCheckDeviceExists(t, site, device.MAC)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckDeviceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeviceConfig_withSettings(device.MAC, `
+	snmp_location      = "Rack 1"
+	snmp_contact       = "noc@example.com"
+	stp_version        = "rstp"
+	stp_priority       = 4096
+	jumboframe_enabled = true
+	led_override       = "off"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeviceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "snmp_location", "Rack 1"),
+					resource.TestCheckResourceAttr(resourceName, "snmp_contact", "noc@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "stp_version", "rstp"),
+					resource.TestCheckResourceAttr(resourceName, "stp_priority", "4096"),
+					resource.TestCheckResourceAttr(resourceName, "jumboframe_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "led_override", "off"),
+				),
+			},
+			{
+				Config: testAccDeviceConfig_withSettings(device.MAC, `
+	stp_priority       = 32768
+	jumboframe_enabled = false
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "stp_priority", "32768"),
+					resource.TestCheckResourceAttr(resourceName, "jumboframe_enabled", "false"),
+					// not configured, so left unchanged
+					resource.TestCheckResourceAttr(resourceName, "snmp_location", "Rack 1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDevice_pdu_outletOverrides(t *testing.T) {
+	resourceName := "unifi_device.test"
+	site := "default"
+
+	devices, err := testClient.ListDevice(context.Background(), site)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var pdu *unifi.Device
+	for i := range devices {
+		if devices[i].Model == "USPPDUP" || devices[i].Model == "USPPDUHD" {
+			pdu = &devices[i]
+			break
+		}
+	}
+	if pdu == nil {
+		t.Skip("No PDU found")
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			preCheck(t)
+			preCheckDeviceExists(t, site, pdu.MAC)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckDeviceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeviceConfig_withSettings(pdu.MAC, `
+	outlet_override {
+		index = 1
+		name  = "Router"
+	}
+
+	outlet_override {
+		index         = 2
+		name          = "Spare"
+		relay_state   = false
+		cycle_enabled = true
+	}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeviceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "outlet_override.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "outlet_override.*", map[string]string{
+						"index":       "1",
+						"name":        "Router",
+						"relay_state": "true",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "outlet_override.*", map[string]string{
+						"index":         "2",
+						"relay_state":   "false",
+						"cycle_enabled": "true",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func testAccDeviceConfigEmpty() string {
 	return `
 resource "unifi_device" "test" {}
@@ -354,6 +867,84 @@ func testAccDeviceConfig_withPortOverrides(mac string) string {
 `, mac)
 }
 
+func testAccDeviceConfig_withPortOverrideSettings(mac string) string {
+	return fmt.Sprintf(`
+data "unifi_network" "default" {
+	name = "Default"
+}
+
+resource "unifi_device" "test" {
+	mac = %q
+
+	port_override {
+		number  = 1
+		forward = "customize"
+
+		native_network_id = data.unifi_network.default.id
+
+		isolation                 = true
+		port_security_enabled     = true
+		port_security_mac_address = ["00:00:5e:00:53:02"]
+
+		egress_rate_limit_kbps_enabled = true
+		egress_rate_limit_kbps         = 10000
+
+		stormctrl_type          = "level"
+		stormctrl_bcast_enabled = true
+		stormctrl_bcast_level   = 10
+	}
+
+	port_override {
+		number      = 2
+		autoneg     = false
+		speed       = 100
+		full_duplex = true
+	}
+
+	port_override {
+		number          = 3
+		op_mode         = "mirror"
+		mirror_port_idx = 1
+	}
+}
+`, mac)
+}
+
+func testAccDeviceConfig_withRadios(mac, radios string) string {
+	return fmt.Sprintf(`
+resource "unifi_device" "test" {
+	mac = %q
+%s
+}
+`, mac, radios)
+}
+
+func testAccDeviceConfig_withManagement(mac, management string) string {
+	return fmt.Sprintf(`
+data "unifi_network" "default" {
+	name = "Default"
+}
+
+resource "unifi_device" "test" {
+	mac = %q
+
+	management {
+		network_id = data.unifi_network.default.id
+%s
+	}
+}
+`, mac, management)
+}
+
+func testAccDeviceConfig_withSettings(mac, settings string) string {
+	return fmt.Sprintf(`
+resource "unifi_device" "test" {
+	mac = %q
+%s
+}
+`, mac, settings)
+}
+
 func testAccCheckDeviceDestroy(s *terraform.State) error {
 	ctx := context.Background()
 
@@ -0,0 +1,274 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/paultyng/go-unifi/unifi"
+)
+
+var (
+	dhcpOptionNameRegexp     = regexp.MustCompile("^[A-Za-z0-9-_]{1,25}$")
+	dhcpOptionHexArrayRegexp = regexp.MustCompile("^[0-9a-fA-F]{2}(:[0-9a-fA-F]{2})*$")
+)
+
+// dhcpOptionReservedCodes are the option codes the controller manages itself, for example
+// 15 for the domain name and 51 for the lease time.
+var dhcpOptionReservedCodes = []int{15, 42, 44, 51, 67, 252}
+
+// dhcpOptionReservedNames are the names of the network settings the controller stores next
+// to the custom option values, all of them prefixed with dhcpd_.
+var dhcpOptionReservedNames = []string{
+	"boot_enabled", "boot_filename", "boot_server", "dns_1", "dns_2", "dns_3", "dns_4", "dns_enabled",
+	"enabled", "gateway", "gateway_enabled", "ip_1", "ip_2", "ip_3", "leasetime", "mac_1", "mac_2", "mac_3",
+	"ntp_1", "ntp_2", "ntp_enabled", "start", "stop", "tftp_server", "time_offset", "time_offset_enabled",
+	"unifi_controller", "wins_1", "wins_2", "wins_enabled", "wpad_url",
+}
+
+// validateDHCPOptionName rejects names colliding with a network setting, ignoring case,
+// dashes and underscores, so dns1 is rejected as well as dns_1.
+func validateDHCPOptionName(v interface{}, k string) (ws []string, errs []error) {
+	s, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	normalize := strings.NewReplacer("_", "", "-", "")
+	name := normalize.Replace(strings.ToLower(s))
+	for _, reserved := range dhcpOptionReservedNames {
+		if name == normalize.Replace(reserved) {
+			return nil, []error{fmt.Errorf("expected %q to not collide with the network setting dhcpd_%s, got %q", k, reserved, s)}
+		}
+	}
+
+	return nil, nil
+}
+
+func resourceDHCPOption() *schema.Resource {
+	return &schema.Resource{
+		Description: "`unifi_dhcp_option` manages a custom DHCP option of a site. The value of the option is set per network " +
+			"using the `dhcp_option` block of `unifi_network`.",
+
+		CreateContext: resourceDHCPOptionCreate,
+		ReadContext:   resourceDHCPOptionRead,
+		UpdateContext: resourceDHCPOptionUpdate,
+		DeleteContext: resourceDHCPOptionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importSiteAndID,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of the DHCP option.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"site": {
+				Description: "The name of the site to associate the DHCP option with.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description: "The name of the DHCP option. Names of the built-in DHCP settings of a network, such as `dns_1` " +
+					"or `boot_filename`, can not be used.",
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringMatch(dhcpOptionNameRegexp, "must be 1 to 25 letters, digits, dashes or underscores"),
+					validateDHCPOptionName,
+				),
+			},
+			"code": {
+				Description: "The option code, for example `150` for the TFTP server addresses of Cisco phones. Must be between 7 and 254, " +
+					"the codes managed by the controller (15, 42, 44, 51, 67 and 252) can not be used.",
+				Type:     schema.TypeInt,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.IntBetween(7, 254),
+					validation.IntNotInSlice(dhcpOptionReservedCodes),
+				),
+			},
+			"type": {
+				Description:  "The type of the value of the option, valid values are `boolean`, `hexarray`, `integer`, `ipaddress`, `macaddress` and `text`.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"boolean", "hexarray", "integer", "ipaddress", "macaddress", "text"}, false),
+			},
+			"width": {
+				Description:  "The width in bits of an `integer` option, valid values are `8`, `16` and `32`.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntInSlice([]int{8, 16, 32}),
+			},
+			"signed": {
+				Description: "Whether an `integer` option is signed.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func resourceDHCPOptionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	req, err := resourceDHCPOptionGetResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+
+	resp, err := c.c.CreateDHCPOption(ctx, site, req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resp.ID)
+
+	return resourceDHCPOptionSetResourceData(resp, d, site)
+}
+
+func resourceDHCPOptionGetResourceData(d *schema.ResourceData) (*unifi.DHCPOption, error) {
+	optionType := d.Get("type").(string)
+	width := d.Get("width").(int)
+	signed := d.Get("signed").(bool)
+	switch {
+	case optionType == "integer" && width == 0:
+		return nil, fmt.Errorf("width is required for integer options")
+	case optionType != "integer" && (width != 0 || signed):
+		return nil, fmt.Errorf("width and signed are only valid for integer options")
+	}
+
+	return &unifi.DHCPOption{
+		Name:   d.Get("name").(string),
+		Code:   strconv.Itoa(d.Get("code").(int)),
+		Type:   optionType,
+		Width:  width,
+		Signed: signed,
+	}, nil
+}
+
+// dhcpOptionValidateValue checks a value set on a network matches the type of the option.
+func dhcpOptionValidateValue(opt *unifi.DHCPOption, value string) error {
+	switch opt.Type {
+	case "boolean":
+		if value != "true" && value != "false" {
+			return fmt.Errorf("expected true or false")
+		}
+	case "integer":
+		var err error
+		if opt.Signed {
+			_, err = strconv.ParseInt(value, 10, opt.Width)
+		} else {
+			_, err = strconv.ParseUint(value, 10, opt.Width)
+		}
+		if err != nil {
+			return fmt.Errorf("expected an integer of %d bits", opt.Width)
+		}
+	case "ipaddress":
+		for _, ip := range strings.Split(value, ",") {
+			if net.ParseIP(strings.TrimSpace(ip)).To4() == nil {
+				return fmt.Errorf("expected a comma separated list of IPv4 addresses")
+			}
+		}
+	case "macaddress":
+		if !macAddressRegexp.MatchString(value) {
+			return fmt.Errorf("expected a MAC address")
+		}
+	case "hexarray":
+		if !dhcpOptionHexArrayRegexp.MatchString(value) {
+			return fmt.Errorf("expected colon separated hex bytes such as 01:0a:ff")
+		}
+	}
+	return nil
+}
+
+func resourceDHCPOptionSetResourceData(resp *unifi.DHCPOption, d *schema.ResourceData, site string) diag.Diagnostics {
+	code, err := strconv.Atoi(resp.Code)
+	if err != nil {
+		return diag.Errorf("unable to parse code %q of DHCP option: %s", resp.Code, err)
+	}
+
+	d.Set("site", site)
+	d.Set("name", resp.Name)
+	d.Set("code", code)
+	d.Set("type", resp.Type)
+	d.Set("width", resp.Width)
+	d.Set("signed", resp.Signed)
+
+	return nil
+}
+
+func resourceDHCPOptionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	id := d.Id()
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+
+	resp, err := c.c.GetDHCPOption(ctx, site, id)
+	if _, ok := err.(*unifi.NotFoundError); ok {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDHCPOptionSetResourceData(resp, d, site)
+}
+
+func resourceDHCPOptionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	req, err := resourceDHCPOptionGetResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req.ID = d.Id()
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+
+	resp, err := c.c.UpdateDHCPOption(ctx, site, req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDHCPOptionSetResourceData(resp, d, site)
+}
+
+func resourceDHCPOptionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	id := d.Id()
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+	err := c.c.DeleteDHCPOption(ctx, site, id)
+	if _, ok := err.(*unifi.NotFoundError); ok {
+		return nil
+	}
+	return diag.FromErr(err)
+}
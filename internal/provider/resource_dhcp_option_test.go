@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDHCPOption_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix("tfacc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			preCheck(t)
+			preCheckMinVersion(t, controllerV7)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDHCPOptionConfig(name, "ipaddress", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_dhcp_option.test", "name", name),
+					resource.TestCheckResourceAttr("unifi_dhcp_option.test", "code", "150"),
+					resource.TestCheckResourceAttr("unifi_dhcp_option.test", "type", "ipaddress"),
+				),
+			},
+			importStep("unifi_dhcp_option.test"),
+			{
+				Config: testAccDHCPOptionConfig(name, "integer", "width = 32\n\tsigned = true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_dhcp_option.test", "type", "integer"),
+					resource.TestCheckResourceAttr("unifi_dhcp_option.test", "width", "32"),
+					resource.TestCheckResourceAttr("unifi_dhcp_option.test", "signed", "true"),
+				),
+			},
+			importStep("unifi_dhcp_option.test"),
+			{
+				Config:      testAccDHCPOptionConfig(name, "integer", ""),
+				ExpectError: regexp.MustCompile("width is required for integer options"),
+			},
+		},
+	})
+}
+
+func TestAccDHCPOption_reservedCode(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { preCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "unifi_dhcp_option" "test" {
+	name = "tfacc-reserved"
+	code = 51
+	type = "text"
+}
+`,
+				ExpectError: regexp.MustCompile("expected code to not be any of"),
+			},
+		},
+	})
+}
+
+func TestAccDHCPOption_reservedName(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { preCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "unifi_dhcp_option" "test" {
+	name = "dns1"
+	code = 150
+	type = "ipaddress"
+}
+`,
+				ExpectError: regexp.MustCompile("to not collide with the network setting dhcpd_dns_1"),
+			},
+		},
+	})
+}
+
+func testAccDHCPOptionConfig(name, optionType, extra string) string {
+	return fmt.Sprintf(`
+resource "unifi_dhcp_option" "test" {
+	name = "%s"
+	code = 150
+	type = "%s"
+	%s
+}
+`, name, optionType, extra)
+}
@@ -0,0 +1,234 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/paultyng/go-unifi/unifi"
+)
+
+func resourceDPIApp() *schema.Resource {
+	return &schema.Resource{
+		Description: "`unifi_dpi_app` manages a DPI (deep packet inspection) restriction, which blocks or rate limits " +
+			"applications and application categories. Restrictions are applied to WLANs through a `unifi_dpi_group`.",
+
+		CreateContext: resourceDPIAppCreate,
+		ReadContext:   resourceDPIAppRead,
+		UpdateContext: resourceDPIAppUpdate,
+		DeleteContext: resourceDPIAppDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importSiteAndID,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of the DPI restriction.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"site": {
+				Description: "The name of the site to associate the DPI restriction with.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description:  "The name of the DPI restriction.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"enabled": {
+				Description: "Specifies whether the DPI restriction is applied.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"blocked": {
+				Description: "Specifies whether the traffic of the applications and categories is blocked.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"log": {
+				Description: "Specifies whether matching traffic is logged.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"app_ids": {
+				Description: "The IDs of the DPI applications the restriction applies to.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				AtLeastOneOf: []string{"app_ids", "category_ids"},
+			},
+			"category_ids": {
+				Description: "The IDs of the DPI application categories the restriction applies to, for example `4` for media streaming services.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				AtLeastOneOf: []string{"app_ids", "category_ids"},
+			},
+			"qos_rate_max_down": {
+				Description: "The download rate limit in Kbps for matching traffic, `-1` for no limit.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     -1,
+				ValidateFunc: validation.Any(
+					validation.IntInSlice([]int{-1}),
+					validation.IntBetween(2, 102400),
+				),
+			},
+			"qos_rate_max_up": {
+				Description: "The upload rate limit in Kbps for matching traffic, `-1` for no limit.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     -1,
+				ValidateFunc: validation.Any(
+					validation.IntInSlice([]int{-1}),
+					validation.IntBetween(2, 102400),
+				),
+			},
+		},
+	}
+}
+
+func resourceDPIAppCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	req, err := resourceDPIAppGetResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+
+	resp, err := c.c.CreateDpiApp(ctx, site, req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resp.ID)
+
+	return resourceDPIAppSetResourceData(resp, d, site)
+}
+
+func resourceDPIAppGetResourceData(d *schema.ResourceData) (*unifi.DpiApp, error) {
+	apps, err := setToIntSlice(d.Get("app_ids").(*schema.Set))
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert app_ids to int slice: %w", err)
+	}
+	cats, err := setToIntSlice(d.Get("category_ids").(*schema.Set))
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert category_ids to int slice: %w", err)
+	}
+
+	return &unifi.DpiApp{
+		Name:           d.Get("name").(string),
+		Enabled:        d.Get("enabled").(bool),
+		Blocked:        d.Get("blocked").(bool),
+		Log:            d.Get("log").(bool),
+		Apps:           apps,
+		Cats:           cats,
+		QOSRateMaxDown: d.Get("qos_rate_max_down").(int),
+		QOSRateMaxUp:   d.Get("qos_rate_max_up").(int),
+	}, nil
+}
+
+// resourceDPIAppClearedFields returns the applications and categories that are not configured as
+// explicit empty lists. They omit empty values, so they are sent explicitly to clear them on the controller.
+func resourceDPIAppClearedFields(a *unifi.DpiApp) map[string]interface{} {
+	fields := map[string]interface{}{}
+	if len(a.Apps) == 0 {
+		fields["apps"] = []int{}
+	}
+	if len(a.Cats) == 0 {
+		fields["cats"] = []int{}
+	}
+	return fields
+}
+
+func resourceDPIAppSetResourceData(resp *unifi.DpiApp, d *schema.ResourceData, site string) diag.Diagnostics {
+	d.Set("site", site)
+	d.Set("name", resp.Name)
+	d.Set("enabled", resp.Enabled)
+	d.Set("blocked", resp.Blocked)
+	d.Set("log", resp.Log)
+	d.Set("app_ids", intSliceToSet(resp.Apps))
+	d.Set("category_ids", intSliceToSet(resp.Cats))
+	d.Set("qos_rate_max_down", resp.QOSRateMaxDown)
+	d.Set("qos_rate_max_up", resp.QOSRateMaxUp)
+
+	return nil
+}
+
+func resourceDPIAppRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	id := d.Id()
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+
+	resp, err := c.c.GetDpiApp(ctx, site, id)
+	if _, ok := err.(*unifi.NotFoundError); ok {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDPIAppSetResourceData(resp, d, site)
+}
+
+func resourceDPIAppUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	req, err := resourceDPIAppGetResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req.ID = d.Id()
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+
+	resp, err := c.c.UpdateDpiAppFields(ctx, site, req, resourceDPIAppClearedFields(req))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDPIAppSetResourceData(resp, d, site)
+}
+
+func resourceDPIAppDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	id := d.Id()
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+	err := c.c.DeleteDpiApp(ctx, site, id)
+	if _, ok := err.(*unifi.NotFoundError); ok {
+		return nil
+	}
+	return diag.FromErr(err)
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDPIApp_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix("tfacc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { preCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDPIAppConfig_blocked(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_dpi_app.test", "name", name),
+					resource.TestCheckResourceAttr("unifi_dpi_app.test", "blocked", "true"),
+					resource.TestCheckResourceAttr("unifi_dpi_app.test", "category_ids.#", "1"),
+					resource.TestCheckResourceAttr("unifi_dpi_app.test", "qos_rate_max_down", "-1"),
+				),
+			},
+			importStep("unifi_dpi_app.test"),
+			{
+				Config: testAccDPIAppConfig_qos(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_dpi_app.test", "blocked", "false"),
+					resource.TestCheckResourceAttr("unifi_dpi_app.test", "app_ids.#", "2"),
+					resource.TestCheckResourceAttr("unifi_dpi_app.test", "qos_rate_max_down", "2048"),
+					resource.TestCheckResourceAttr("unifi_dpi_app.test", "qos_rate_max_up", "512"),
+				),
+			},
+			importStep("unifi_dpi_app.test"),
+			{
+				Config: testAccDPIAppConfig_blocked(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_dpi_app.test", "app_ids.#", "0"),
+					resource.TestCheckResourceAttr("unifi_dpi_app.test", "category_ids.#", "1"),
+					resource.TestCheckResourceAttr("unifi_dpi_app.test", "qos_rate_max_down", "-1"),
+					resource.TestCheckResourceAttr("unifi_dpi_app.test", "qos_rate_max_up", "-1"),
+				),
+			},
+			importStep("unifi_dpi_app.test"),
+		},
+	})
+}
+
+func testAccDPIAppConfig_blocked(name string) string {
+	return fmt.Sprintf(`
+resource "unifi_dpi_app" "test" {
+	name         = "%s"
+	blocked      = true
+	log          = true
+	category_ids = [4]
+}
+`, name)
+}
+
+func testAccDPIAppConfig_qos(name string) string {
+	return fmt.Sprintf(`
+resource "unifi_dpi_app" "test" {
+	name    = "%s"
+	app_ids = [262256, 262275]
+
+	qos_rate_max_down = 2048
+	qos_rate_max_up   = 512
+}
+`, name)
+}
@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/paultyng/go-unifi/unifi"
+)
+
+func resourceDPIGroup() *schema.Resource {
+	return &schema.Resource{
+		Description: "`unifi_dpi_group` manages a group of DPI (deep packet inspection) restrictions that can be " +
+			"applied to a WLAN using its `dpi_group_id`.",
+
+		CreateContext: resourceDPIGroupCreate,
+		ReadContext:   resourceDPIGroupRead,
+		UpdateContext: resourceDPIGroupUpdate,
+		DeleteContext: resourceDPIGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importSiteAndID,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of the DPI group.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"site": {
+				Description: "The name of the site to associate the DPI group with.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description:  "The name of the DPI group.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"enabled": {
+				Description: "Specifies whether the restrictions of the DPI group are applied.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"dpi_app_ids": {
+				Description: "The IDs of the DPI restrictions in this group. You can manage these with the `unifi_dpi_app` resource.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceDPIGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	req, err := resourceDPIGroupGetResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+
+	resp, err := c.c.CreateDpiGroup(ctx, site, req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resp.ID)
+
+	return resourceDPIGroupSetResourceData(resp, d, site)
+}
+
+func resourceDPIGroupGetResourceData(d *schema.ResourceData) (*unifi.DpiGroup, error) {
+	appIDs, err := setToStringSlice(d.Get("dpi_app_ids").(*schema.Set))
+	if err != nil {
+		return nil, err
+	}
+
+	return &unifi.DpiGroup{
+		Name:      d.Get("name").(string),
+		Enabled:   d.Get("enabled").(bool),
+		DPIappIDs: appIDs,
+	}, nil
+}
+
+// resourceDPIGroupClearedFields returns the DPI app IDs as an explicit empty list when none are
+// configured. They omit empty values, so they are sent explicitly to clear them on the controller.
+func resourceDPIGroupClearedFields(g *unifi.DpiGroup) map[string]interface{} {
+	fields := map[string]interface{}{}
+	if len(g.DPIappIDs) == 0 {
+		fields["dpiapp_ids"] = []string{}
+	}
+	return fields
+}
+
+func resourceDPIGroupSetResourceData(resp *unifi.DpiGroup, d *schema.ResourceData, site string) diag.Diagnostics {
+	d.Set("site", site)
+	d.Set("name", resp.Name)
+	d.Set("enabled", resp.Enabled)
+	d.Set("dpi_app_ids", stringSliceToSet(resp.DPIappIDs))
+
+	return nil
+}
+
+func resourceDPIGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	id := d.Id()
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+
+	resp, err := c.c.GetDpiGroup(ctx, site, id)
+	if _, ok := err.(*unifi.NotFoundError); ok {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDPIGroupSetResourceData(resp, d, site)
+}
+
+func resourceDPIGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	req, err := resourceDPIGroupGetResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req.ID = d.Id()
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+
+	resp, err := c.c.UpdateDpiGroupFields(ctx, site, req, resourceDPIGroupClearedFields(req))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDPIGroupSetResourceData(resp, d, site)
+}
+
+func resourceDPIGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	id := d.Id()
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+	err := c.c.DeleteDpiGroup(ctx, site, id)
+	if _, ok := err.(*unifi.NotFoundError); ok {
+		return nil
+	}
+	return diag.FromErr(err)
+}
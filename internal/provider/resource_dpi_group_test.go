@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDPIGroup_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix("tfacc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { preCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDPIGroupConfig_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_dpi_group.test", "name", name),
+					resource.TestCheckResourceAttr("unifi_dpi_group.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("unifi_dpi_group.test", "dpi_app_ids.#", "0"),
+				),
+			},
+			importStep("unifi_dpi_group.test"),
+			{
+				Config: testAccDPIGroupConfig_apps(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_dpi_group.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("unifi_dpi_group.test", "dpi_app_ids.#", "2"),
+				),
+			},
+			importStep("unifi_dpi_group.test"),
+			{
+				Config: testAccDPIGroupConfig_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_dpi_group.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("unifi_dpi_group.test", "dpi_app_ids.#", "0"),
+				),
+			},
+			importStep("unifi_dpi_group.test"),
+		},
+	})
+}
+
+func testAccDPIGroupConfig_basic(name string) string {
+	return fmt.Sprintf(`
+resource "unifi_dpi_group" "test" {
+	name = "%s"
+}
+`, name)
+}
+
+func testAccDPIGroupConfig_apps(name string) string {
+	return fmt.Sprintf(`
+resource "unifi_dpi_app" "streaming" {
+	name         = "%[1]s-streaming"
+	blocked      = true
+	category_ids = [4]
+}
+
+resource "unifi_dpi_app" "games" {
+	name         = "%[1]s-games"
+	category_ids = [8]
+
+	qos_rate_max_down = 1000
+	qos_rate_max_up   = 500
+}
+
+resource "unifi_dpi_group" "test" {
+	name    = "%[1]s"
+	enabled = false
+
+	dpi_app_ids = [
+		unifi_dpi_app.streaming.id,
+		unifi_dpi_app.games.id,
+	]
+}
+`, name)
+}
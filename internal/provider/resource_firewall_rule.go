@@ -3,6 +3,7 @@
 import (
 	"context"
 	"errors"
+	"fmt"
 	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -15,6 +16,8 @@
 var firewallRuleProtocolV6Regexp = regexp.MustCompile("^$|([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])|ah|all|dccp|eigrp|esp|gre|icmpv6|ipcomp|ipv6|ipv6-frag|ipv6-icmp|ipv6-nonxt|ipv6-opts|ipv6-route|isis|l2tp|manet|mobility-header|mpls-in-ip|ospf|pim|rsvp|sctp|shim6|tcp|tcp_udp|udp|vrrp")
 var firewallRuleICMPv6TypenameRegexp = regexp.MustCompile("^$|address-unreachable|bad-header|beyond-scope|communication-prohibited|destination-unreachable|echo-reply|echo-request|failed-policy|neighbor-advertisement|neighbor-solicitation|no-route|packet-too-big|parameter-problem|port-unreachable|redirect|reject-route|router-advertisement|router-solicitation|time-exceeded|ttl-zero-during-reassembly|ttl-zero-during-transit|unknown-header-type|unknown-option")
 
+var firewallRuleRulesets = []string{"WAN_IN", "WAN_OUT", "WAN_LOCAL", "LAN_IN", "LAN_OUT", "LAN_LOCAL", "GUEST_IN", "GUEST_OUT", "GUEST_LOCAL", "WANv6_IN", "WANv6_OUT", "WANv6_LOCAL", "LANv6_IN", "LANv6_OUT", "LANv6_LOCAL", "GUESTv6_IN", "GUESTv6_OUT", "GUESTv6_LOCAL"}
+
 func resourceFirewallRule() *schema.Resource {
 	return &schema.Resource{
 		Description: "`unifi_firewall_rule` manages an individual firewall rule on the gateway.",
@@ -58,12 +61,15 @@ func resourceFirewallRule() *schema.Resource {
 					"`LANv6_LOCAL`, `GUESTv6_IN`, `GUESTv6_OUT`, or `GUESTv6_LOCAL`.",
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: validation.StringInSlice([]string{"WAN_IN", "WAN_OUT", "WAN_LOCAL", "LAN_IN", "LAN_OUT", "LAN_LOCAL", "GUEST_IN", "GUEST_OUT", "GUEST_LOCAL", "WANv6_IN", "WANv6_OUT", "WANv6_LOCAL", "LANv6_IN", "LANv6_OUT", "LANv6_LOCAL", "GUESTv6_IN", "GUESTv6_OUT", "GUESTv6_LOCAL"}, false),
+				ValidateFunc: validation.StringInSlice(firewallRuleRulesets, false),
 			},
 			"rule_index": {
-				Description: "The index of the rule. Must be >= 2000 < 3000 or >= 4000 < 5000.",
-				Type:        schema.TypeInt,
-				Required:    true,
+				Description: "The index of the rule. Must be >= 2000 < 3000 or >= 4000 < 5000. If not set, the rule is " +
+					"placed after the existing rules of the ruleset in the 2000 range. Leave this unset when the order " +
+					"of the ruleset is managed with `unifi_firewall_rule_order`.",
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 				// 2[0-9]{3}|4[0-9]{3}
 			},
 			"protocol": {
@@ -222,6 +228,17 @@ func resourceFirewallRuleCreate(ctx context.Context, d *schema.ResourceData, met
 		site = c.site
 	}
 
+	if req.RuleIndex == 0 {
+		// hold the lock until the rule is created so concurrent creates don't pick the same index
+		firewallRuleIndexLock.Lock()
+		defer firewallRuleIndexLock.Unlock()
+
+		req.RuleIndex, err = nextFirewallRuleIndex(ctx, c, site, req.Ruleset)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	resp, err := c.c.CreateFirewallRule(ctx, site, req)
 	if err != nil {
 		var apiErr *unifi.APIError
@@ -237,6 +254,29 @@ func resourceFirewallRuleCreate(ctx context.Context, d *schema.ResourceData, met
 	return resourceFirewallRuleSetResourceData(resp, d, site)
 }
 
+func nextFirewallRuleIndex(ctx context.Context, c *client, site, ruleset string) (int, error) {
+	rules, err := c.c.ListFirewallRule(ctx, site)
+	if err != nil {
+		return 0, err
+	}
+
+	next := firewallRuleIndexBefore
+	for _, r := range rules {
+		if r.Ruleset != ruleset || r.RuleIndex < firewallRuleIndexBefore || r.RuleIndex >= firewallRuleIndexBefore+firewallRuleIndexRangeSize {
+			continue
+		}
+		if r.RuleIndex >= next {
+			next = r.RuleIndex + 1
+		}
+	}
+
+	if next >= firewallRuleIndexBefore+firewallRuleIndexRangeSize {
+		return 0, fmt.Errorf("no free rule_index left in ruleset %s, set rule_index explicitly", ruleset)
+	}
+
+	return next, nil
+}
+
 func resourceFirewallRuleGetResourceData(d *schema.ResourceData) (*unifi.FirewallRule, error) {
 	srcFirewallGroupIDs, err := setToStringSlice(d.Get("src_firewall_group_ids").(*schema.Set))
 	if err != nil {
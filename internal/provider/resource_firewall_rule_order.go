@@ -0,0 +1,288 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/paultyng/go-unifi/unifi"
+)
+
+// user defined rules are evaluated either before (2000-2999) or after (4000-4999)
+// the rules predefined by the controller
+const (
+	firewallRuleIndexBefore    = 2000
+	firewallRuleIndexAfter     = 4000
+	firewallRuleIndexRangeSize = 1000
+)
+
+// firewallRuleIndexLock serializes changes to rule indices, so automatically assigned
+// indices and reorders don't collide.
+var firewallRuleIndexLock = sync.Mutex{}
+
+func resourceFirewallRuleOrder() *schema.Resource {
+	return &schema.Resource{
+		Description: "`unifi_firewall_rule_order` manages the order of the firewall rules of a ruleset. " +
+			"Rule indices are assigned automatically from the order of the rule IDs, so the `rule_index` of the " +
+			"`unifi_firewall_rule` resources should be left unset. Destroying this resource leaves the rules in their " +
+			"current order.",
+
+		CreateContext: resourceFirewallRuleOrderApply,
+		ReadContext:   resourceFirewallRuleOrderRead,
+		UpdateContext: resourceFirewallRuleOrderApply,
+		DeleteContext: schema.NoopContext,
+		Importer: &schema.ResourceImporter{
+			StateContext: importSiteAndID,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of the firewall rule order, which is the name of the ruleset.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"site": {
+				Description: "The name of the site to associate the firewall rule order with.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"ruleset": {
+				Description: "The ruleset to order, for example `LAN_IN`. Must be one of `WAN_IN`, `WAN_OUT`, " +
+					"`WAN_LOCAL`, `LAN_IN`, `LAN_OUT`, `LAN_LOCAL`, `GUEST_IN`, `GUEST_OUT`, `GUEST_LOCAL`, `WANv6_IN`, " +
+					"`WANv6_OUT`, `WANv6_LOCAL`, `LANv6_IN`, `LANv6_OUT`, `LANv6_LOCAL`, `GUESTv6_IN`, `GUESTv6_OUT`, " +
+					"or `GUESTv6_LOCAL`.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(firewallRuleRulesets, false),
+			},
+			"rule_ids": {
+				Description: "The IDs of the firewall rules evaluated before the predefined rules, in order. " +
+					"They are assigned indices starting at 2000.",
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: firewallRuleIndexRangeSize,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				AtLeastOneOf: []string{"rule_ids", "after_predefined_rule_ids"},
+			},
+			"after_predefined_rule_ids": {
+				Description: "The IDs of the firewall rules evaluated after the predefined rules, in order. " +
+					"They are assigned indices starting at 4000.",
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: firewallRuleIndexRangeSize,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				AtLeastOneOf: []string{"rule_ids", "after_predefined_rule_ids"},
+			},
+		},
+	}
+}
+
+func resourceFirewallRuleOrderGetIndices(d *schema.ResourceData) (map[string]int, error) {
+	before, err := listToStringSlice(d.Get("rule_ids").([]interface{}))
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert rule_ids to string slice: %w", err)
+	}
+	after, err := listToStringSlice(d.Get("after_predefined_rule_ids").([]interface{}))
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert after_predefined_rule_ids to string slice: %w", err)
+	}
+
+	indices := map[string]int{}
+	for start, ids := range map[int][]string{
+		firewallRuleIndexBefore: before,
+		firewallRuleIndexAfter:  after,
+	} {
+		for i, id := range ids {
+			if _, ok := indices[id]; ok {
+				return nil, fmt.Errorf("firewall rule %q is listed more than once", id)
+			}
+			indices[id] = start + i
+		}
+	}
+
+	return indices, nil
+}
+
+func resourceFirewallRuleOrderApply(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+	ruleset := d.Get("ruleset").(string)
+
+	indices, err := resourceFirewallRuleOrderGetIndices(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	firewallRuleIndexLock.Lock()
+	defer firewallRuleIndexLock.Unlock()
+
+	rules, err := c.c.ListFirewallRule(ctx, site)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	managed := map[string]unifi.FirewallRule{}
+	used := map[int]bool{}
+	for _, r := range rules {
+		if r.Ruleset != ruleset {
+			continue
+		}
+		used[r.RuleIndex] = true
+		if _, ok := indices[r.ID]; ok {
+			managed[r.ID] = r
+			continue
+		}
+		for id, index := range indices {
+			if index == r.RuleIndex {
+				return diag.Errorf("rule_index %d for firewall rule %q is already used by firewall rule %q (%s) "+
+					"which is not part of the order of ruleset %s", index, id, r.ID, r.Name, ruleset)
+			}
+		}
+	}
+
+	changed := []string{}
+	for id, index := range indices {
+		r, ok := managed[id]
+		if !ok {
+			return diag.Errorf("firewall rule %q was not found in ruleset %s", id, ruleset)
+		}
+		if r.RuleIndex != index {
+			changed = append(changed, id)
+		}
+		used[index] = true
+	}
+	sort.Strings(changed)
+
+	// move the changed rules to unused indices of their target range first, so swapping
+	// two rules never assigns an index that is still held by another rule
+	free := map[int][]int{}
+	for _, id := range changed {
+		start := firewallRuleIndexRangeStart(indices[id])
+		if _, ok := free[start]; !ok {
+			free[start] = firewallRuleFreeIndices(used, start)
+		}
+		if len(free[start]) == 0 {
+			return diag.Errorf("no free index left between %d and %d in ruleset %s to reorder firewall rule %q",
+				start, start+firewallRuleIndexRangeSize-1, ruleset, id)
+		}
+
+		r := managed[id]
+		r.RuleIndex = free[start][0]
+		free[start] = free[start][1:]
+		resp, err := c.c.UpdateFirewallRule(ctx, site, &r)
+		if err != nil {
+			return diag.Errorf("unable to move firewall rule %q to temporary index %d: %s", id, r.RuleIndex, err)
+		}
+		managed[id] = *resp
+	}
+	for _, id := range changed {
+		r := managed[id]
+		r.RuleIndex = indices[id]
+		_, err := c.c.UpdateFirewallRule(ctx, site, &r)
+		if err != nil {
+			return diag.Errorf("unable to move firewall rule %q to index %d: %s", id, indices[id], err)
+		}
+	}
+
+	d.SetId(ruleset)
+
+	return resourceFirewallRuleOrderRead(ctx, d, meta)
+}
+
+// firewallRuleIndexRangeStart returns the start of the range of user defined rules the
+// index belongs to.
+func firewallRuleIndexRangeStart(index int) int {
+	if index >= firewallRuleIndexAfter {
+		return firewallRuleIndexAfter
+	}
+	return firewallRuleIndexBefore
+}
+
+// firewallRuleFreeIndices returns the unused indices of the range starting at start,
+// highest first.
+func firewallRuleFreeIndices(used map[int]bool, start int) []int {
+	free := []int{}
+	for i := start + firewallRuleIndexRangeSize - 1; i >= start; i-- {
+		if !used[i] {
+			free = append(free, i)
+		}
+	}
+	return free
+}
+
+func resourceFirewallRuleOrderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+	ruleset := d.Id()
+
+	indices, err := resourceFirewallRuleOrderGetIndices(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rules, err := c.c.ListFirewallRule(ctx, site)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ordered := []unifi.FirewallRule{}
+	for _, r := range rules {
+		if r.Ruleset != ruleset {
+			continue
+		}
+		if _, ok := indices[r.ID]; ok {
+			ordered = append(ordered, r)
+			continue
+		}
+		// on import, the order of all user defined rules of the ruleset is managed
+		if len(indices) == 0 && (r.RuleIndex >= firewallRuleIndexBefore && r.RuleIndex < firewallRuleIndexBefore+firewallRuleIndexRangeSize ||
+			r.RuleIndex >= firewallRuleIndexAfter && r.RuleIndex < firewallRuleIndexAfter+firewallRuleIndexRangeSize) {
+			ordered = append(ordered, r)
+		}
+	}
+	if len(indices) == 0 && len(ordered) == 0 {
+		return diag.Errorf("no user defined firewall rules found in ruleset %s", ruleset)
+	}
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].RuleIndex < ordered[j].RuleIndex
+	})
+
+	// rules reordered or moved in the UI show up as a difference in the lists
+	before := []string{}
+	after := []string{}
+	for _, r := range ordered {
+		if r.RuleIndex >= firewallRuleIndexAfter {
+			after = append(after, r.ID)
+			continue
+		}
+		before = append(before, r.ID)
+	}
+
+	d.Set("site", site)
+	d.Set("ruleset", ruleset)
+	d.Set("rule_ids", before)
+	d.Set("after_predefined_rule_ids", after)
+
+	return nil
+}
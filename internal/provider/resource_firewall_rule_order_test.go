@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFirewallRuleOrder_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix("tfacc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { preCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallRuleOrderConfig(name, "first", "second", "third"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("unifi_firewall_rule_order.test", "rule_ids.0", "unifi_firewall_rule.first", "id"),
+					resource.TestCheckResourceAttrPair("unifi_firewall_rule_order.test", "rule_ids.1", "unifi_firewall_rule.second", "id"),
+					resource.TestCheckResourceAttrPair("unifi_firewall_rule_order.test", "rule_ids.2", "unifi_firewall_rule.third", "id"),
+				),
+			},
+			importStep("unifi_firewall_rule_order.test"),
+			{
+				Config: testAccFirewallRuleOrderConfig(name, "third", "first", "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("unifi_firewall_rule_order.test", "rule_ids.0", "unifi_firewall_rule.third", "id"),
+					resource.TestCheckResourceAttrPair("unifi_firewall_rule_order.test", "rule_ids.1", "unifi_firewall_rule.first", "id"),
+					resource.TestCheckResourceAttrPair("unifi_firewall_rule_order.test", "rule_ids.2", "unifi_firewall_rule.second", "id"),
+				),
+			},
+			{
+				// the rule resources pick up the new indices on refresh
+				Config:   testAccFirewallRuleOrderConfig(name, "third", "first", "second"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccFirewallRuleOrder_afterPredefined(t *testing.T) {
+	name := acctest.RandomWithPrefix("tfacc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { preCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallRuleOrderConfig_afterPredefined(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("unifi_firewall_rule_order.test", "rule_ids.0", "unifi_firewall_rule.before", "id"),
+					resource.TestCheckResourceAttrPair("unifi_firewall_rule_order.test", "after_predefined_rule_ids.0", "unifi_firewall_rule.after", "id"),
+				),
+			},
+			importStep("unifi_firewall_rule_order.test"),
+		},
+	})
+}
+
+func TestAccFirewallRuleOrder_afterPredefinedOnly(t *testing.T) {
+	name := acctest.RandomWithPrefix("tfacc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { preCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallRuleOrderConfig_afterPredefinedOnly(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_firewall_rule_order.test", "rule_ids.#", "0"),
+					resource.TestCheckResourceAttrPair("unifi_firewall_rule_order.test", "after_predefined_rule_ids.0", "unifi_firewall_rule.second", "id"),
+					resource.TestCheckResourceAttrPair("unifi_firewall_rule_order.test", "after_predefined_rule_ids.1", "unifi_firewall_rule.first", "id"),
+				),
+			},
+			importStep("unifi_firewall_rule_order.test"),
+		},
+	})
+}
+
+func TestAccFirewallRuleOrder_duplicate(t *testing.T) {
+	name := acctest.RandomWithPrefix("tfacc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { preCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFirewallRuleOrderConfig(name, "first", "second", "first"),
+				ExpectError: regexp.MustCompile("is listed more than once"),
+			},
+		},
+	})
+}
+
+func testAccFirewallRuleOrderConfig(name string, order ...string) string {
+	return fmt.Sprintf(`
+resource "unifi_firewall_rule" "first" {
+	name    = "%[1]s-first"
+	action  = "accept"
+	ruleset = "GUEST_LOCAL"
+
+	protocol    = "all"
+	dst_address = "192.168.1.1"
+}
+
+resource "unifi_firewall_rule" "second" {
+	name    = "%[1]s-second"
+	action  = "accept"
+	ruleset = "GUEST_LOCAL"
+
+	protocol    = "all"
+	dst_address = "192.168.1.2"
+}
+
+resource "unifi_firewall_rule" "third" {
+	name    = "%[1]s-third"
+	action  = "drop"
+	ruleset = "GUEST_LOCAL"
+
+	protocol    = "all"
+	dst_address = "192.168.1.3"
+}
+
+resource "unifi_firewall_rule_order" "test" {
+	ruleset = "GUEST_LOCAL"
+
+	rule_ids = [
+		unifi_firewall_rule.%[2]s.id,
+		unifi_firewall_rule.%[3]s.id,
+		unifi_firewall_rule.%[4]s.id,
+	]
+}
+`, name, order[0], order[1], order[2])
+}
+
+func testAccFirewallRuleOrderConfig_afterPredefined(name string) string {
+	return fmt.Sprintf(`
+resource "unifi_firewall_rule" "before" {
+	name    = "%[1]s-before"
+	action  = "accept"
+	ruleset = "GUEST_OUT"
+
+	protocol    = "all"
+	dst_address = "192.168.1.1"
+}
+
+resource "unifi_firewall_rule" "after" {
+	name    = "%[1]s-after"
+	action  = "drop"
+	ruleset = "GUEST_OUT"
+
+	protocol    = "all"
+	dst_address = "192.168.1.2"
+}
+
+resource "unifi_firewall_rule_order" "test" {
+	ruleset = "GUEST_OUT"
+
+	rule_ids                  = [unifi_firewall_rule.before.id]
+	after_predefined_rule_ids = [unifi_firewall_rule.after.id]
+}
+`, name)
+}
+
+func testAccFirewallRuleOrderConfig_afterPredefinedOnly(name string) string {
+	return fmt.Sprintf(`
+resource "unifi_firewall_rule" "first" {
+	name    = "%[1]s-first"
+	action  = "accept"
+	ruleset = "LAN_OUT"
+
+	protocol    = "all"
+	dst_address = "192.168.1.1"
+}
+
+resource "unifi_firewall_rule" "second" {
+	name    = "%[1]s-second"
+	action  = "drop"
+	ruleset = "LAN_OUT"
+
+	protocol    = "all"
+	dst_address = "192.168.1.2"
+}
+
+resource "unifi_firewall_rule_order" "test" {
+	ruleset = "LAN_OUT"
+
+	after_predefined_rule_ids = [
+		unifi_firewall_rule.second.id,
+		unifi_firewall_rule.first.id,
+	]
+}
+`, name)
+}
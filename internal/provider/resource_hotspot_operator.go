@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/paultyng/go-unifi/unifi"
+)
+
+func resourceHotspotOperator() *schema.Resource {
+	return &schema.Resource{
+		Description: "`unifi_hotspot_operator` manages a hotspot operator, an account that can only manage guests and vouchers of the hotspot.",
+
+		CreateContext: resourceHotspotOperatorCreate,
+		ReadContext:   resourceHotspotOperatorRead,
+		UpdateContext: resourceHotspotOperatorUpdate,
+		DeleteContext: resourceHotspotOperatorDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importSiteAndID,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of the hotspot operator.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"site": {
+				Description: "The name of the site to associate the hotspot operator with.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description:  "The name of the hotspot operator.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"note": {
+				Description: "A note with additional information for the hotspot operator.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"password": {
+				Description:  "The password of the hotspot operator.",
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+		},
+	}
+}
+
+func resourceHotspotOperatorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	req, err := resourceHotspotOperatorGetResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+
+	resp, err := c.c.CreateHotspotOp(ctx, site, req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resp.ID)
+
+	return resourceHotspotOperatorSetResourceData(resp, d, site)
+}
+
+func resourceHotspotOperatorGetResourceData(d *schema.ResourceData) (*unifi.HotspotOp, error) {
+	return &unifi.HotspotOp{
+		Name:      d.Get("name").(string),
+		Note:      d.Get("note").(string),
+		XPassword: d.Get("password").(string),
+	}, nil
+}
+
+func resourceHotspotOperatorSetResourceData(resp *unifi.HotspotOp, d *schema.ResourceData, site string) diag.Diagnostics {
+	d.Set("site", site)
+	d.Set("name", resp.Name)
+	d.Set("note", resp.Note)
+	d.Set("password", resp.XPassword)
+
+	return nil
+}
+
+func resourceHotspotOperatorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	id := d.Id()
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+
+	resp, err := c.c.GetHotspotOp(ctx, site, id)
+	if _, ok := err.(*unifi.NotFoundError); ok {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceHotspotOperatorSetResourceData(resp, d, site)
+}
+
+func resourceHotspotOperatorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	req, err := resourceHotspotOperatorGetResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req.ID = d.Id()
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+
+	resp, err := c.c.UpdateHotspotOp(ctx, site, req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceHotspotOperatorSetResourceData(resp, d, site)
+}
+
+func resourceHotspotOperatorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	id := d.Id()
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+	err := c.c.DeleteHotspotOp(ctx, site, id)
+	if _, ok := err.(*unifi.NotFoundError); ok {
+		return nil
+	}
+	return diag.FromErr(err)
+}
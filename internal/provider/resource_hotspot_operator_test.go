@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccHotspotOperator_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix("tfacc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { preCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHotspotOperatorConfig(name, "front desk", "s3cr3t"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_hotspot_operator.test", "name", name),
+					resource.TestCheckResourceAttr("unifi_hotspot_operator.test", "note", "front desk"),
+				),
+			},
+			importStep("unifi_hotspot_operator.test"),
+			{
+				Config: testAccHotspotOperatorConfig(name, "", "n3ws3cr3t"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_hotspot_operator.test", "note", ""),
+					resource.TestCheckResourceAttr("unifi_hotspot_operator.test", "password", "n3ws3cr3t"),
+				),
+			},
+			importStep("unifi_hotspot_operator.test"),
+		},
+	})
+}
+
+func testAccHotspotOperatorConfig(name, note, password string) string {
+	return fmt.Sprintf(`
+resource "unifi_hotspot_operator" "test" {
+	name     = "%s"
+	note     = "%s"
+	password = "%s"
+}
+`, name, note, password)
+}
@@ -0,0 +1,321 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/paultyng/go-unifi/unifi"
+)
+
+var hotspotPackageCurrencyRegexp = regexp.MustCompile("^[A-Z]{3}$")
+
+// hotspotPackagePaymentFields maps the customer details that can be collected on payment to their
+// enabled and required flags.
+var hotspotPackagePaymentFields = map[string]func(p *unifi.HotspotPackage) (*bool, *bool){
+	"first_name": func(p *unifi.HotspotPackage) (*bool, *bool) {
+		return &p.PaymentFieldsFirstNameEnabled, &p.PaymentFieldsFirstNameRequired
+	},
+	"last_name": func(p *unifi.HotspotPackage) (*bool, *bool) {
+		return &p.PaymentFieldsLastNameEnabled, &p.PaymentFieldsLastNameRequired
+	},
+	"email": func(p *unifi.HotspotPackage) (*bool, *bool) {
+		return &p.PaymentFieldsEmailEnabled, &p.PaymentFieldsEmailRequired
+	},
+	"address": func(p *unifi.HotspotPackage) (*bool, *bool) {
+		return &p.PaymentFieldsAddressEnabled, &p.PaymentFieldsAddressRequired
+	},
+	"city": func(p *unifi.HotspotPackage) (*bool, *bool) {
+		return &p.PaymentFieldsCityEnabled, &p.PaymentFieldsCityRequired
+	},
+	"state": func(p *unifi.HotspotPackage) (*bool, *bool) {
+		return &p.PaymentFieldsStateEnabled, &p.PaymentFieldsStateRequired
+	},
+	"zip": func(p *unifi.HotspotPackage) (*bool, *bool) {
+		return &p.PaymentFieldsZipEnabled, &p.PaymentFieldsZipRequired
+	},
+	"country": func(p *unifi.HotspotPackage) (*bool, *bool) {
+		return &p.PaymentFieldsCountryEnabled, &p.PaymentFieldsCountryRequired
+	},
+}
+
+func resourceHotspotPackage() *schema.Resource {
+	paymentFields := map[string]*schema.Schema{}
+	for f := range hotspotPackagePaymentFields {
+		paymentFields[f] = &schema.Schema{
+			Description:  "Whether the field is `disabled`, `optional` or `required`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "disabled",
+			ValidateFunc: validation.StringInSlice([]string{"disabled", "optional", "required"}, false),
+		}
+	}
+
+	return &schema.Resource{
+		Description: "`unifi_hotspot_package` manages a package guests can purchase to access the hotspot.",
+
+		CreateContext: resourceHotspotPackageCreate,
+		ReadContext:   resourceHotspotPackageRead,
+		UpdateContext: resourceHotspotPackageUpdate,
+		DeleteContext: resourceHotspotPackageDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importSiteAndID,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of the hotspot package.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"site": {
+				Description: "The name of the site to associate the hotspot package with.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description: "The name of the hotspot package.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"amount": {
+				Description:  "The price of the package.",
+				Type:         schema.TypeFloat,
+				Required:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+			"currency": {
+				Description:  "The ISO 4217 code of the currency of `amount`, for example `USD`.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(hotspotPackageCurrencyRegexp, "must be an upper case ISO 4217 currency code"),
+			},
+			"hours": {
+				Description:  "The number of hours of access included in the package.",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"index": {
+				Description: "The position of the package in the list shown to guests.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"down_limit_kbps": {
+				Description:  "The download bandwidth limit in Kbps, overriding the limits of the user group.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"up_limit_kbps": {
+				Description:  "The upload bandwidth limit in Kbps, overriding the limits of the user group.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"quota_mbytes": {
+				Description:  "The data transfer quota in MB.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"trial_duration_minutes": {
+				Description:  "The length of a free trial in minutes, `0` disables the trial.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"trial_reset_hours": {
+				Description:  "The number of hours after which a guest can use the trial again.",
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+			"payment_fields": {
+				Description: "The customer details collected on payment. Without this block the defaults of the payment gateway are used.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: paymentFields,
+				},
+			},
+		},
+	}
+}
+
+func resourceHotspotPackageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	req, err := resourceHotspotPackageGetResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+
+	resp, err := c.c.CreateHotspotPackage(ctx, site, req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resp.ID)
+
+	return resourceHotspotPackageSetResourceData(resp, d, site)
+}
+
+func resourceHotspotPackageGetResourceData(d *schema.ResourceData) (*unifi.HotspotPackage, error) {
+	p := &unifi.HotspotPackage{
+		Name:                 d.Get("name").(string),
+		Amount:               d.Get("amount").(float64),
+		Currency:             d.Get("currency").(string),
+		Hours:                d.Get("hours").(int),
+		Index:                d.Get("index").(int),
+		LimitDown:            d.Get("down_limit_kbps").(int),
+		LimitUp:              d.Get("up_limit_kbps").(int),
+		LimitQuota:           d.Get("quota_mbytes").(int),
+		TrialDurationMinutes: d.Get("trial_duration_minutes").(int),
+		TrialReset:           d.Get("trial_reset_hours").(float64),
+	}
+	p.LimitOverwrite = p.LimitDown != 0 || p.LimitUp != 0 || p.LimitQuota != 0
+
+	if v, ok := d.GetOk("payment_fields.0"); ok {
+		fields := v.(map[string]interface{})
+		p.CustomPaymentFieldsEnabled = true
+		for f, flags := range hotspotPackagePaymentFields {
+			enabled, required := flags(p)
+			*enabled = fields[f].(string) != "disabled"
+			*required = fields[f].(string) == "required"
+		}
+	}
+
+	return p, nil
+}
+
+// hotspotPackageZeroFields returns the limits and trial settings that are 0. They omit zero values, so they
+// are sent explicitly to reset them on the controller.
+func hotspotPackageZeroFields(p *unifi.HotspotPackage) map[string]interface{} {
+	fields := map[string]interface{}{}
+	for k, v := range map[string]float64{
+		"limit_down":             float64(p.LimitDown),
+		"limit_up":               float64(p.LimitUp),
+		"limit_quota":            float64(p.LimitQuota),
+		"trial_duration_minutes": float64(p.TrialDurationMinutes),
+		"trial_reset":            p.TrialReset,
+	} {
+		if v == 0 {
+			fields[k] = 0
+		}
+	}
+	return fields
+}
+
+func resourceHotspotPackageSetResourceData(resp *unifi.HotspotPackage, d *schema.ResourceData, site string) diag.Diagnostics {
+	d.Set("site", site)
+	d.Set("name", resp.Name)
+	d.Set("amount", resp.Amount)
+	d.Set("currency", resp.Currency)
+	d.Set("hours", resp.Hours)
+	d.Set("index", resp.Index)
+	d.Set("trial_duration_minutes", resp.TrialDurationMinutes)
+	d.Set("trial_reset_hours", resp.TrialReset)
+
+	if resp.LimitOverwrite {
+		d.Set("down_limit_kbps", resp.LimitDown)
+		d.Set("up_limit_kbps", resp.LimitUp)
+		d.Set("quota_mbytes", resp.LimitQuota)
+	} else {
+		d.Set("down_limit_kbps", 0)
+		d.Set("up_limit_kbps", 0)
+		d.Set("quota_mbytes", 0)
+	}
+
+	paymentFields := []interface{}{}
+	if resp.CustomPaymentFieldsEnabled {
+		fields := map[string]interface{}{}
+		for f, flags := range hotspotPackagePaymentFields {
+			enabled, required := flags(resp)
+			switch {
+			case *required:
+				fields[f] = "required"
+			case *enabled:
+				fields[f] = "optional"
+			default:
+				fields[f] = "disabled"
+			}
+		}
+		paymentFields = append(paymentFields, fields)
+	}
+	d.Set("payment_fields", paymentFields)
+
+	return nil
+}
+
+func resourceHotspotPackageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	id := d.Id()
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+
+	resp, err := c.c.GetHotspotPackage(ctx, site, id)
+	if _, ok := err.(*unifi.NotFoundError); ok {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceHotspotPackageSetResourceData(resp, d, site)
+}
+
+func resourceHotspotPackageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	req, err := resourceHotspotPackageGetResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req.ID = d.Id()
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+
+	resp, err := c.c.UpdateHotspotPackageFields(ctx, site, req, hotspotPackageZeroFields(req))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceHotspotPackageSetResourceData(resp, d, site)
+}
+
+func resourceHotspotPackageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	id := d.Id()
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+	err := c.c.DeleteHotspotPackage(ctx, site, id)
+	if _, ok := err.(*unifi.NotFoundError); ok {
+		return nil
+	}
+	return diag.FromErr(err)
+}
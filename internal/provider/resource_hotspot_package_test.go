@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccHotspotPackage_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix("tfacc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { preCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHotspotPackageConfig_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_hotspot_package.test", "name", name),
+					resource.TestCheckResourceAttr("unifi_hotspot_package.test", "amount", "4.99"),
+					resource.TestCheckResourceAttr("unifi_hotspot_package.test", "payment_fields.#", "0"),
+				),
+			},
+			importStep("unifi_hotspot_package.test"),
+			{
+				Config: testAccHotspotPackageConfig_limits(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_hotspot_package.test", "down_limit_kbps", "20000"),
+					resource.TestCheckResourceAttr("unifi_hotspot_package.test", "quota_mbytes", "1024"),
+					resource.TestCheckResourceAttr("unifi_hotspot_package.test", "payment_fields.0.email", "required"),
+					resource.TestCheckResourceAttr("unifi_hotspot_package.test", "payment_fields.0.first_name", "optional"),
+					resource.TestCheckResourceAttr("unifi_hotspot_package.test", "payment_fields.0.zip", "disabled"),
+					resource.TestCheckResourceAttr("unifi_hotspot_package.test", "trial_duration_minutes", "30"),
+				),
+			},
+			importStep("unifi_hotspot_package.test"),
+			{
+				// removing the limits and the trial resets them
+				Config: testAccHotspotPackageConfig_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_hotspot_package.test", "down_limit_kbps", "0"),
+					resource.TestCheckResourceAttr("unifi_hotspot_package.test", "quota_mbytes", "0"),
+					resource.TestCheckResourceAttr("unifi_hotspot_package.test", "trial_duration_minutes", "0"),
+					resource.TestCheckResourceAttr("unifi_hotspot_package.test", "trial_reset_hours", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccHotspotPackageConfig_basic(name string) string {
+	return fmt.Sprintf(`
+resource "unifi_hotspot_package" "test" {
+	name     = "%s"
+	amount   = 4.99
+	currency = "USD"
+	hours    = 24
+}
+`, name)
+}
+
+func testAccHotspotPackageConfig_limits(name string) string {
+	return fmt.Sprintf(`
+resource "unifi_hotspot_package" "test" {
+	name     = "%s"
+	amount   = 9.99
+	currency = "EUR"
+	hours    = 168
+
+	down_limit_kbps = 20000
+	up_limit_kbps   = 5000
+	quota_mbytes    = 1024
+
+	trial_duration_minutes = 30
+	trial_reset_hours      = 24
+
+	payment_fields {
+		email      = "required"
+		first_name = "optional"
+	}
+}
+`, name)
+}
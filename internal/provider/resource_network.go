@@ -151,6 +151,28 @@ func resourceNetwork() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 			},
+			"dhcp_option": {
+				Description: "The value of a custom DHCP option handed out on this network. Requires controller version 7.0 or higher. " +
+					"Option values set outside of Terraform are only detected while at least one `dhcp_option` block is configured.",
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"option_id": {
+							Description: "The ID of the DHCP option. You can manage these with the `unifi_dhcp_option` resource.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"value": {
+							Description: "The value of the option, formatted according to the type of the option. " +
+								"Values of `hexarray` options are written as colon separated bytes, for example `01:0a:ff`.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
 			"dhcp_relay_enabled": {
 				Description: "Specifies whether DHCP relay is enabled or not on this network.",
 				Type:        schema.TypeBool,
@@ -394,7 +416,18 @@ func resourceNetworkCreate(ctx context.Context, d *schema.ResourceData, meta int
 
 	d.SetId(resp.ID)
 
-	return resourceNetworkSetResourceData(resp, d, site)
+	if d.Get("dhcp_option").(*schema.Set).Len() > 0 {
+		err = resourceNetworkApplyDHCPOptions(ctx, d, meta, site)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	diags := resourceNetworkSetResourceData(resp, d, site)
+	if diags.HasError() {
+		return diags
+	}
+	return resourceNetworkReadDHCPOptions(ctx, d, meta, site)
 }
 
 func resourceNetworkGetResourceData(d *schema.ResourceData, meta interface{}) (*unifi.Network, error) {
@@ -635,7 +668,11 @@ func resourceNetworkRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return diag.FromErr(err)
 	}
 
-	return resourceNetworkSetResourceData(resp, d, site)
+	diags := resourceNetworkSetResourceData(resp, d, site)
+	if diags.HasError() {
+		return diags
+	}
+	return resourceNetworkReadDHCPOptions(ctx, d, meta, site)
 }
 
 func resourceNetworkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -658,7 +695,103 @@ func resourceNetworkUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		return diag.FromErr(err)
 	}
 
-	return resourceNetworkSetResourceData(resp, d, site)
+	if d.HasChange("dhcp_option") {
+		err = resourceNetworkApplyDHCPOptions(ctx, d, meta, site)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	diags := resourceNetworkSetResourceData(resp, d, site)
+	if diags.HasError() {
+		return diags
+	}
+	return resourceNetworkReadDHCPOptions(ctx, d, meta, site)
+}
+
+// resourceNetworkApplyDHCPOptions sets the values of the configured custom DHCP options and
+// removes the values of options no longer configured.
+func resourceNetworkApplyDHCPOptions(ctx context.Context, d *schema.ResourceData, meta interface{}, site string) error {
+	c := meta.(*client)
+
+	o, n := d.GetChange("dhcp_option")
+	configured := n.(*schema.Set).List()
+	if v := c.ControllerVersion(); len(configured) > 0 && v.LessThan(controllerV7) {
+		return fmt.Errorf("custom DHCP options are not supported on controller version %q, you must be on %q or higher", v, controllerV7)
+	}
+
+	values := map[string]string{}
+	for _, raw := range o.(*schema.Set).List() {
+		opt, err := c.c.GetDHCPOption(ctx, site, raw.(map[string]interface{})["option_id"].(string))
+		if _, ok := err.(*unifi.NotFoundError); ok {
+			// the option was deleted, so is its value
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		values[opt.Name] = ""
+	}
+	for _, raw := range configured {
+		m := raw.(map[string]interface{})
+		opt, err := c.c.GetDHCPOption(ctx, site, m["option_id"].(string))
+		if err != nil {
+			return fmt.Errorf("unable to find DHCP option %q: %w", m["option_id"], err)
+		}
+		value := m["value"].(string)
+		if err = dhcpOptionValidateValue(opt, value); err != nil {
+			return fmt.Errorf("invalid value %q for DHCP option %s (%s): %w", value, opt.Name, opt.Type, err)
+		}
+		values[opt.Name] = value
+	}
+
+	if len(values) == 0 {
+		return nil
+	}
+	return c.c.UpdateNetworkDHCPOptionValues(ctx, site, d.Id(), values)
+}
+
+// resourceNetworkReadDHCPOptions reads the custom DHCP option values of the network, but only when
+// some are managed, as this takes two more requests. Values set outside of Terraform are not
+// detected on networks without a dhcp_option block.
+func resourceNetworkReadDHCPOptions(ctx context.Context, d *schema.ResourceData, meta interface{}, site string) diag.Diagnostics {
+	if d.Get("dhcp_option").(*schema.Set).Len() == 0 {
+		return nil
+	}
+	return diag.FromErr(resourceNetworkSetDHCPOptions(ctx, d, meta, site))
+}
+
+// resourceNetworkSetDHCPOptions reads the values of all custom DHCP options of the site set on the network.
+func resourceNetworkSetDHCPOptions(ctx context.Context, d *schema.ResourceData, meta interface{}, site string) error {
+	c := meta.(*client)
+
+	dhcpOptions := []interface{}{}
+	if c.ControllerVersion().LessThan(controllerV7) {
+		d.Set("dhcp_option", dhcpOptions)
+		return nil
+	}
+
+	opts, err := c.c.ListDHCPOption(ctx, site)
+	if err != nil {
+		return err
+	}
+	if len(opts) > 0 {
+		values, err := c.c.GetNetworkDHCPOptionValues(ctx, site, d.Id())
+		if err != nil {
+			return err
+		}
+		for _, opt := range opts {
+			if v := values[opt.Name]; v != "" {
+				dhcpOptions = append(dhcpOptions, map[string]interface{}{
+					"option_id": opt.ID,
+					"value":     v,
+				})
+			}
+		}
+	}
+	d.Set("dhcp_option", dhcpOptions)
+
+	return nil
 }
 
 func resourceNetworkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -707,6 +840,13 @@ func importNetwork(ctx context.Context, d *schema.ResourceData, meta interface{}
 		d.Set("site", site)
 	}
 
+	// read skips the custom DHCP options unless some are managed, so pick them up here, a
+	// missing network is reported by read
+	err := resourceNetworkSetDHCPOptions(ctx, d, meta, site)
+	if _, ok := err.(*unifi.NotFoundError); err != nil && !ok {
+		return nil, err
+	}
+
 	return []*schema.ResourceData{d}, nil
 }
 
@@ -445,6 +445,40 @@ func quoteStrings(src []string) []string {
 	return dst
 }
 
+func TestAccNetwork_dhcpOption(t *testing.T) {
+	name := acctest.RandomWithPrefix("tfacc")
+	subnet, vlan := getTestVLAN(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			preCheck(t)
+			preCheckMinVersion(t, controllerV7)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkConfigDHCPOption(name, subnet, vlan, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_network.test", "dhcp_option.#", "2"),
+				),
+			},
+			importStep("unifi_network.test"),
+			{
+				Config: testAccNetworkConfigDHCPOption(name, subnet, vlan, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_network.test", "dhcp_option.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("unifi_network.test", "dhcp_option.*.option_id", "unifi_dhcp_option.tftp", "id"),
+				),
+			},
+			importStep("unifi_network.test"),
+			{
+				Config:      testAccNetworkConfigDHCPOptionInvalid(name, subnet, vlan),
+				ExpectError: regexp.MustCompile("expected a comma separated list of IPv4 addresses"),
+			},
+		},
+	})
+}
+
 func testAccNetworkConfigDHCPBoot(name string, subnet *net.IPNet, vlan int) string {
 	return fmt.Sprintf(`
 locals {
@@ -693,3 +727,65 @@ func testAccNetworkConfigMDNS(name string, subnet *net.IPNet, vlan int, mdns boo
 }
 `, name, subnet, vlan, mdns)
 }
+
+func testAccNetworkConfigDHCPOption(name string, subnet *net.IPNet, vlan int, withVLANOption bool) string {
+	vlanOption := ""
+	if withVLANOption {
+		vlanOption = `
+	dhcp_option {
+		option_id = unifi_dhcp_option.vlan.id
+		value     = "100"
+	}
+`
+	}
+
+	return fmt.Sprintf(`
+resource "unifi_dhcp_option" "tftp" {
+	name = "%[1]s-tftp"
+	code = 150
+	type = "ipaddress"
+}
+
+resource "unifi_dhcp_option" "vlan" {
+	name  = "%[1]s-vlan"
+	code  = 132
+	type  = "integer"
+	width = 16
+}
+
+resource "unifi_network" "test" {
+	name    = "%[1]s"
+	purpose = "corporate"
+	subnet  = "%[2]s"
+	vlan_id = %[3]d
+
+	dhcp_option {
+		option_id = unifi_dhcp_option.tftp.id
+		value     = "10.0.0.10,10.0.0.11"
+	}
+%[4]s
+}
+`, name, subnet, vlan, vlanOption)
+}
+
+func testAccNetworkConfigDHCPOptionInvalid(name string, subnet *net.IPNet, vlan int) string {
+	return fmt.Sprintf(`
+resource "unifi_dhcp_option" "tftp" {
+	name = "%[1]s-tftp"
+	code = 150
+	type = "ipaddress"
+}
+
+resource "unifi_network" "test" {
+	name    = "%[1]s"
+	purpose = "corporate"
+	subnet  = "%[2]s"
+	vlan_id = %[3]d
+
+	dhcp_option {
+		option_id = unifi_dhcp_option.tftp.id
+		value     = "tftp.example.com"
+	}
+}
+`, name, subnet, vlan)
+}
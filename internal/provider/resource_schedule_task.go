@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/paultyng/go-unifi/unifi"
+)
+
+func resourceScheduleTask() *schema.Resource {
+	return &schema.Resource{
+		Description: "`unifi_schedule_task` manages a scheduled firmware upgrade of devices, for example to upgrade " +
+			"access points in a maintenance window.",
+
+		CreateContext: resourceScheduleTaskCreate,
+		ReadContext:   resourceScheduleTaskRead,
+		UpdateContext: resourceScheduleTaskUpdate,
+		DeleteContext: resourceScheduleTaskDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importSiteAndID,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of the scheduled task.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"site": {
+				Description: "The name of the site to associate the scheduled task with.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description: "The name of the scheduled task.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"cron_expr": {
+				Description:  "When the task runs, as a cron expression with five fields in the time zone of the site, for example `0 3 * * 0` for Sundays at 3 AM.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: cronValidate,
+			},
+			"execute_only_once": {
+				Description: "Whether the task is removed from the schedule after it ran once.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"upgrade_target": {
+				Description: "A device to upgrade.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mac": {
+							Description:      "The MAC address of the device.",
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validation.StringMatch(macAddressRegexp, "Mac address is invalid"),
+							DiffSuppressFunc: macDiffSuppressFunc,
+						},
+						"firmware": {
+							Description: "The firmware version to upgrade the device to, for example `6.5.55.14777`. Defaults to the latest available firmware.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceScheduleTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	req, err := resourceScheduleTaskGetResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+
+	resp, err := c.c.CreateScheduleTask(ctx, site, req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resp.ID)
+
+	return resourceScheduleTaskSetResourceData(resp, d, site)
+}
+
+func resourceScheduleTaskGetResourceData(d *schema.ResourceData) (*unifi.ScheduleTask, error) {
+	targets := []unifi.ScheduleTaskUpgradeTargets{}
+	for _, v := range d.Get("upgrade_target").([]interface{}) {
+		t := v.(map[string]interface{})
+		targets = append(targets, unifi.ScheduleTaskUpgradeTargets{
+			MAC:               cleanMAC(t["mac"].(string)),
+			UpgradeToFirmware: t["firmware"].(string),
+		})
+	}
+
+	return &unifi.ScheduleTask{
+		Name:            d.Get("name").(string),
+		Action:          "upgrade",
+		CronExpr:        d.Get("cron_expr").(string),
+		ExecuteOnlyOnce: d.Get("execute_only_once").(bool),
+		UpgradeTargets:  targets,
+	}, nil
+}
+
+func resourceScheduleTaskSetResourceData(resp *unifi.ScheduleTask, d *schema.ResourceData, site string) diag.Diagnostics {
+	targets := []interface{}{}
+	for _, t := range resp.UpgradeTargets {
+		targets = append(targets, map[string]interface{}{
+			"mac":      t.MAC,
+			"firmware": t.UpgradeToFirmware,
+		})
+	}
+
+	d.Set("site", site)
+	d.Set("name", resp.Name)
+	d.Set("cron_expr", resp.CronExpr)
+	d.Set("execute_only_once", resp.ExecuteOnlyOnce)
+	d.Set("upgrade_target", targets)
+
+	return nil
+}
+
+func resourceScheduleTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	id := d.Id()
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+
+	resp, err := c.c.GetScheduleTask(ctx, site, id)
+	if _, ok := err.(*unifi.NotFoundError); ok {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceScheduleTaskSetResourceData(resp, d, site)
+}
+
+func resourceScheduleTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	req, err := resourceScheduleTaskGetResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req.ID = d.Id()
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+
+	resp, err := c.c.UpdateScheduleTask(ctx, site, req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceScheduleTaskSetResourceData(resp, d, site)
+}
+
+func resourceScheduleTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	id := d.Id()
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+	err := c.c.DeleteScheduleTask(ctx, site, id)
+	if _, ok := err.(*unifi.NotFoundError); ok {
+		return nil
+	}
+	return diag.FromErr(err)
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccScheduleTask_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix("tfacc")
+	mac, unallocateTestMac := allocateTestMac(t)
+	defer unallocateTestMac()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { preCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleTaskConfig(name, "0 3 * * 0", mac, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_schedule_task.test", "name", name),
+					resource.TestCheckResourceAttr("unifi_schedule_task.test", "cron_expr", "0 3 * * 0"),
+					resource.TestCheckResourceAttr("unifi_schedule_task.test", "upgrade_target.#", "1"),
+					resource.TestCheckResourceAttr("unifi_schedule_task.test", "upgrade_target.0.mac", mac),
+				),
+			},
+			importStep("unifi_schedule_task.test"),
+			{
+				Config: testAccScheduleTaskConfig(name, "30 2 1 * *", mac, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_schedule_task.test", "cron_expr", "30 2 1 * *"),
+					resource.TestCheckResourceAttr("unifi_schedule_task.test", "execute_only_once", "true"),
+				),
+			},
+			importStep("unifi_schedule_task.test"),
+		},
+	})
+}
+
+func TestAccScheduleTask_invalidCron(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { preCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccScheduleTaskConfig("tfacc-invalid", "0 25 * * *", "00:00:5e:00:53:00", false),
+				ExpectError: regexp.MustCompile(`hour "25": must be between 0 and 23`),
+			},
+		},
+	})
+}
+
+func testAccScheduleTaskConfig(name, cron, mac string, once bool) string {
+	return fmt.Sprintf(`
+resource "unifi_schedule_task" "test" {
+	name              = "%s"
+	cron_expr         = "%s"
+	execute_only_once = %t
+
+	upgrade_target {
+		mac = "%s"
+	}
+}
+`, name, cron, once, mac)
+}
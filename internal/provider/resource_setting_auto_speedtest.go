@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/paultyng/go-unifi/unifi"
+)
+
+func resourceSettingAutoSpeedtest() *schema.Resource {
+	return &schema.Resource{
+		Description: "`unifi_setting_auto_speedtest` manages the schedule of the automatic WAN speed tests of a unifi site.",
+
+		CreateContext: resourceSettingAutoSpeedtestUpsert,
+		ReadContext:   resourceSettingAutoSpeedtestRead,
+		UpdateContext: resourceSettingAutoSpeedtestUpsert,
+		DeleteContext: schema.NoopContext,
+		Importer: &schema.ResourceImporter{
+			StateContext: importSiteAndID,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of the settings.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"site": {
+				Description: "The name of the site to associate the settings with.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"enabled": {
+				Description: "Whether speed tests run automatically.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"cron_expr": {
+				Description:  "When speed tests run, as a cron expression with five fields in the time zone of the site, for example `0 */6 * * *`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: cronValidate,
+			},
+		},
+	}
+}
+
+func resourceSettingAutoSpeedtestUpdateResourceData(d *schema.ResourceData, setting *unifi.SettingAutoSpeedtest) error {
+	setting.Enabled = d.Get("enabled").(bool)
+	setting.CronExpr = d.Get("cron_expr").(string)
+
+	return nil
+}
+
+func resourceSettingAutoSpeedtestUpsert(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+
+	req, err := c.c.GetSettingAutoSpeedtest(ctx, site)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = resourceSettingAutoSpeedtestUpdateResourceData(d, req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := c.c.UpdateSettingAutoSpeedtest(ctx, site, req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resp.ID)
+	return resourceSettingAutoSpeedtestSetResourceData(resp, d, site)
+}
+
+func resourceSettingAutoSpeedtestSetResourceData(resp *unifi.SettingAutoSpeedtest, d *schema.ResourceData, site string) diag.Diagnostics {
+	d.Set("site", site)
+	d.Set("enabled", resp.Enabled)
+	d.Set("cron_expr", resp.CronExpr)
+
+	return nil
+}
+
+func resourceSettingAutoSpeedtestRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+
+	resp, err := c.c.GetSettingAutoSpeedtest(ctx, site)
+	if _, ok := err.(*unifi.NotFoundError); ok {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceSettingAutoSpeedtestSetResourceData(resp, d, site)
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var settingAutoSpeedtestLock = sync.Mutex{}
+
+func TestAccSettingAutoSpeedtest_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			preCheck(t)
+			settingAutoSpeedtestLock.Lock()
+			t.Cleanup(func() {
+				settingAutoSpeedtestLock.Unlock()
+			})
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSettingAutoSpeedtestConfig(true, "0 */6 * * *"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_setting_auto_speedtest.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("unifi_setting_auto_speedtest.test", "cron_expr", "0 */6 * * *"),
+				),
+			},
+			importStep("unifi_setting_auto_speedtest.test"),
+			{
+				Config: testAccSettingAutoSpeedtestConfig(false, "0 4 * * *"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_setting_auto_speedtest.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("unifi_setting_auto_speedtest.test", "cron_expr", "0 4 * * *"),
+				),
+			},
+			importStep("unifi_setting_auto_speedtest.test"),
+		},
+	})
+}
+
+func testAccSettingAutoSpeedtestConfig(enabled bool, cron string) string {
+	return fmt.Sprintf(`
+resource "unifi_setting_auto_speedtest" "test" {
+	enabled   = %t
+	cron_expr = "%s"
+}
+`, enabled, cron)
+}
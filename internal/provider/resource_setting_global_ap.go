@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/paultyng/go-unifi/unifi"
+)
+
+// globalAPBands are the radio bands of the site wide access point defaults
+var globalAPBands = []string{"2g", "5g", "6g"}
+
+var globalAPTxPowerModes = []string{"auto", "low", "medium", "high", "custom"}
+
+func resourceSettingGlobalAP() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"id": {
+			Description: "The ID of the settings.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"site": {
+			Description: "The name of the site to associate the settings with.",
+			Type:        schema.TypeString,
+			Computed:    true,
+			Optional:    true,
+			ForceNew:    true,
+		},
+		"ap_exclusions": {
+			Description: "The MAC addresses of access points that do not use these defaults, in lower case and separated by colons.",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringMatch(normalizedMACRegexp, "Mac address must be lower case and colon separated"),
+			},
+		},
+	}
+
+	for _, band := range globalAPBands {
+		channelSizes := []int{20, 40, 80, 160}
+		if band == "2g" {
+			channelSizes = []int{20, 40}
+		}
+
+		s["channel_size_"+band] = &schema.Schema{
+			Description:  fmt.Sprintf("The channel width in MHz of the %s radios, valid values are %s.", band, markdownValueListInt(channelSizes)),
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntInSlice(channelSizes),
+		}
+		s["tx_power_mode_"+band] = &schema.Schema{
+			Description:  fmt.Sprintf("The transmit power of the %s radios, valid values are `auto`, `low`, `medium`, `high` and `custom`.", band),
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(globalAPTxPowerModes, false),
+		}
+		s["tx_power_"+band] = &schema.Schema{
+			Description:  fmt.Sprintf("The transmit power in dBm of the %s radios when `tx_power_mode_%s` is `custom`.", band, band),
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntBetween(0, 49),
+		}
+	}
+
+	return &schema.Resource{
+		Description: "`unifi_setting_global_ap` manages the site wide radio defaults of access points.",
+
+		CreateContext: resourceSettingGlobalAPUpsert,
+		ReadContext:   resourceSettingGlobalAPRead,
+		UpdateContext: resourceSettingGlobalAPUpsert,
+		DeleteContext: schema.NoopContext,
+		Importer: &schema.ResourceImporter{
+			StateContext: importSiteAndID,
+		},
+
+		Schema: s,
+	}
+}
+
+// globalAPBandFields returns the channel size, transmit power mode and transmit power of a band.
+func globalAPBandFields(setting *unifi.SettingGlobalAp, band string) (*int, *string, *int) {
+	switch band {
+	case "2g":
+		return &setting.NgChannelSize, &setting.NgTxPowerMode, &setting.NgTxPower
+	case "5g":
+		return &setting.NaChannelSize, &setting.NaTxPowerMode, &setting.NaTxPower
+	case "6g":
+		return &setting.SixEChannelSize, &setting.SixETxPowerMode, &setting.SixETxPower
+	}
+	panic("unexpected band " + band)
+}
+
+func resourceSettingGlobalAPUpdateResourceData(d *schema.ResourceData, setting *unifi.SettingGlobalAp) error {
+	exclusions, err := setToStringSlice(d.Get("ap_exclusions").(*schema.Set))
+	if err != nil {
+		return fmt.Errorf("unable to convert ap_exclusions to string slice: %w", err)
+	}
+	setting.ApExclusions = exclusions
+
+	for _, band := range globalAPBands {
+		channelSize, txPowerMode, txPower := globalAPBandFields(setting, band)
+		*channelSize = d.Get("channel_size_" + band).(int)
+		*txPowerMode = d.Get("tx_power_mode_" + band).(string)
+		*txPower = d.Get("tx_power_" + band).(int)
+
+		if !d.GetRawConfig().GetAttr("tx_power_"+band).IsNull() && *txPowerMode != "custom" {
+			return fmt.Errorf("tx_power_%s can only be set when tx_power_mode_%s is custom", band, band)
+		}
+	}
+
+	return nil
+}
+
+func resourceSettingGlobalAPUpsert(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+
+	req, err := c.c.GetSettingGlobalAp(ctx, site)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = resourceSettingGlobalAPUpdateResourceData(d, req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := c.c.UpdateSettingGlobalAp(ctx, site, req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resp.ID)
+	return resourceSettingGlobalAPSetResourceData(resp, d, site)
+}
+
+func resourceSettingGlobalAPSetResourceData(resp *unifi.SettingGlobalAp, d *schema.ResourceData, site string) diag.Diagnostics {
+	d.Set("site", site)
+	d.Set("ap_exclusions", stringSliceToSet(resp.ApExclusions))
+
+	for _, band := range globalAPBands {
+		channelSize, txPowerMode, txPower := globalAPBandFields(resp, band)
+		d.Set("channel_size_"+band, *channelSize)
+		d.Set("tx_power_mode_"+band, *txPowerMode)
+		d.Set("tx_power_"+band, *txPower)
+	}
+
+	return nil
+}
+
+func resourceSettingGlobalAPRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+
+	resp, err := c.c.GetSettingGlobalAp(ctx, site)
+	if _, ok := err.(*unifi.NotFoundError); ok {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceSettingGlobalAPSetResourceData(resp, d, site)
+}
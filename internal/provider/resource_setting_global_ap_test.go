@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var settingGlobalAPLock = sync.Mutex{}
+
+func TestAccSettingGlobalAP_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			preCheck(t)
+			settingGlobalAPLock.Lock()
+			t.Cleanup(func() {
+				settingGlobalAPLock.Unlock()
+			})
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSettingGlobalAPConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_setting_global_ap.test", "channel_size_2g", "20"),
+					resource.TestCheckResourceAttr("unifi_setting_global_ap.test", "channel_size_5g", "40"),
+					resource.TestCheckResourceAttr("unifi_setting_global_ap.test", "tx_power_mode_5g", "auto"),
+				),
+			},
+			importStep("unifi_setting_global_ap.test"),
+			{
+				Config: testAccSettingGlobalAPConfig_custom(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_setting_global_ap.test", "tx_power_mode_2g", "custom"),
+					resource.TestCheckResourceAttr("unifi_setting_global_ap.test", "tx_power_2g", "10"),
+					resource.TestCheckResourceAttr("unifi_setting_global_ap.test", "ap_exclusions.#", "1"),
+				),
+			},
+			importStep("unifi_setting_global_ap.test"),
+			{
+				Config:      testAccSettingGlobalAPConfig_invalidTxPower(),
+				ExpectError: regexp.MustCompile("tx_power_5g can only be set when tx_power_mode_5g is custom"),
+			},
+		},
+	})
+}
+
+func testAccSettingGlobalAPConfig_basic() string {
+	return `
+resource "unifi_setting_global_ap" "test" {
+	channel_size_2g  = 20
+	channel_size_5g  = 40
+	tx_power_mode_5g = "auto"
+}
+`
+}
+
+func testAccSettingGlobalAPConfig_custom() string {
+	return `
+resource "unifi_setting_global_ap" "test" {
+	channel_size_2g  = 20
+	channel_size_5g  = 40
+	tx_power_mode_2g = "custom"
+	tx_power_2g      = 10
+	tx_power_mode_5g = "auto"
+
+	ap_exclusions = ["00:00:5e:00:53:ff"]
+}
+`
+}
+
+func testAccSettingGlobalAPConfig_invalidTxPower() string {
+	return `
+resource "unifi_setting_global_ap" "test" {
+	tx_power_mode_5g = "high"
+	tx_power_5g      = 20
+}
+`
+}
@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/paultyng/go-unifi/unifi"
+)
+
+func resourceSettingGlobalSwitch() *schema.Resource {
+	return &schema.Resource{
+		Description: "`unifi_setting_global_switch` manages the site wide defaults of switches, including 802.1X port control.",
+
+		CreateContext: resourceSettingGlobalSwitchUpsert,
+		ReadContext:   resourceSettingGlobalSwitchRead,
+		UpdateContext: resourceSettingGlobalSwitchUpsert,
+		DeleteContext: schema.NoopContext,
+		Importer: &schema.ResourceImporter{
+			StateContext: importSiteAndID,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of the settings.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"site": {
+				Description: "The name of the site to associate the settings with.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"dhcp_snoop": {
+				Description: "Whether DHCP snooping is enabled, so only DHCP servers on trusted ports can hand out leases.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"stp_version": {
+				Description:  "The spanning tree protocol version, valid values are `stp`, `rstp` and `disabled`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"stp", "rstp", "disabled"}, false),
+			},
+			"jumboframe_enabled": {
+				Description: "Whether jumbo frames are enabled.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"flowctrl_enabled": {
+				Description: "Whether flow control is enabled.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"dot1x_portctrl_enabled": {
+				Description: "Whether 802.1X port control is enabled. Requires `radius_profile_id`.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"radius_profile_id": {
+				Description: "The ID of the RADIUS profile used for 802.1X port control. You can manage these with the `unifi_radius_profile` resource.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"dot1x_fallback_network_id": {
+				Description: "The ID of the network clients are placed in when 802.1X authentication fails or is unavailable.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"switch_exclusions": {
+				Description: "The MAC addresses of switches that do not use these defaults, in lower case and separated by colons.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(normalizedMACRegexp, "Mac address must be lower case and colon separated"),
+				},
+			},
+		},
+	}
+}
+
+func resourceSettingGlobalSwitchUpdateResourceData(d *schema.ResourceData, setting *unifi.SettingGlobalSwitch) error {
+	exclusions, err := setToStringSlice(d.Get("switch_exclusions").(*schema.Set))
+	if err != nil {
+		return fmt.Errorf("unable to convert switch_exclusions to string slice: %w", err)
+	}
+
+	setting.DHCPSnoop = d.Get("dhcp_snoop").(bool)
+	setting.StpVersion = d.Get("stp_version").(string)
+	setting.JumboframeEnabled = d.Get("jumboframe_enabled").(bool)
+	setting.FlowctrlEnabled = d.Get("flowctrl_enabled").(bool)
+	setting.Dot1XPortctrlEnabled = d.Get("dot1x_portctrl_enabled").(bool)
+	setting.RADIUSProfileID = d.Get("radius_profile_id").(string)
+	setting.Dot1XFallbackNetworkID = d.Get("dot1x_fallback_network_id").(string)
+	setting.SwitchExclusions = exclusions
+
+	if setting.Dot1XPortctrlEnabled && setting.RADIUSProfileID == "" {
+		return fmt.Errorf("radius_profile_id is required when dot1x_portctrl_enabled is true")
+	}
+
+	return nil
+}
+
+// resourceSettingGlobalSwitchClearedFields returns the switch exclusions as an explicit empty list when
+// none are configured. They omit empty values, so they are sent explicitly to clear them on the controller.
+func resourceSettingGlobalSwitchClearedFields(setting *unifi.SettingGlobalSwitch) map[string]interface{} {
+	fields := map[string]interface{}{}
+	if len(setting.SwitchExclusions) == 0 {
+		fields["switch_exclusions"] = []string{}
+	}
+	return fields
+}
+
+func resourceSettingGlobalSwitchUpsert(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+
+	req, err := c.c.GetSettingGlobalSwitch(ctx, site)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = resourceSettingGlobalSwitchUpdateResourceData(d, req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := c.c.UpdateSettingGlobalSwitchFields(ctx, site, req, resourceSettingGlobalSwitchClearedFields(req))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resp.ID)
+	return resourceSettingGlobalSwitchSetResourceData(resp, d, site)
+}
+
+func resourceSettingGlobalSwitchSetResourceData(resp *unifi.SettingGlobalSwitch, d *schema.ResourceData, site string) diag.Diagnostics {
+	d.Set("site", site)
+	d.Set("dhcp_snoop", resp.DHCPSnoop)
+	d.Set("stp_version", resp.StpVersion)
+	d.Set("jumboframe_enabled", resp.JumboframeEnabled)
+	d.Set("flowctrl_enabled", resp.FlowctrlEnabled)
+	d.Set("dot1x_portctrl_enabled", resp.Dot1XPortctrlEnabled)
+	d.Set("radius_profile_id", resp.RADIUSProfileID)
+	d.Set("dot1x_fallback_network_id", resp.Dot1XFallbackNetworkID)
+	d.Set("switch_exclusions", stringSliceToSet(resp.SwitchExclusions))
+
+	return nil
+}
+
+func resourceSettingGlobalSwitchRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+
+	resp, err := c.c.GetSettingGlobalSwitch(ctx, site)
+	if _, ok := err.(*unifi.NotFoundError); ok {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceSettingGlobalSwitchSetResourceData(resp, d, site)
+}
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var settingGlobalSwitchLock = sync.Mutex{}
+
+func TestAccSettingGlobalSwitch_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix("tfacc")
+	subnet, vlan := getTestVLAN(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			preCheck(t)
+			settingGlobalSwitchLock.Lock()
+			t.Cleanup(func() {
+				settingGlobalSwitchLock.Unlock()
+			})
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSettingGlobalSwitchConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_setting_global_switch.test", "stp_version", "rstp"),
+					resource.TestCheckResourceAttr("unifi_setting_global_switch.test", "dhcp_snoop", "true"),
+					resource.TestCheckResourceAttr("unifi_setting_global_switch.test", "dot1x_portctrl_enabled", "false"),
+				),
+			},
+			importStep("unifi_setting_global_switch.test"),
+			{
+				Config: testAccSettingGlobalSwitchConfig_dot1x(name, subnet, vlan),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_setting_global_switch.test", "dot1x_portctrl_enabled", "true"),
+					resource.TestCheckResourceAttrPair("unifi_setting_global_switch.test", "radius_profile_id", "unifi_radius_profile.test", "id"),
+					resource.TestCheckResourceAttrPair("unifi_setting_global_switch.test", "dot1x_fallback_network_id", "unifi_network.test", "id"),
+					resource.TestCheckResourceAttr("unifi_setting_global_switch.test", "switch_exclusions.#", "1"),
+				),
+			},
+			importStep("unifi_setting_global_switch.test"),
+			{
+				Config: testAccSettingGlobalSwitchConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_setting_global_switch.test", "dot1x_portctrl_enabled", "false"),
+					resource.TestCheckResourceAttr("unifi_setting_global_switch.test", "radius_profile_id", ""),
+					resource.TestCheckResourceAttr("unifi_setting_global_switch.test", "switch_exclusions.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSettingGlobalSwitchConfig_basic() string {
+	return `
+resource "unifi_setting_global_switch" "test" {
+	stp_version            = "rstp"
+	dhcp_snoop             = true
+	dot1x_portctrl_enabled = false
+}
+`
+}
+
+func testAccSettingGlobalSwitchConfig_dot1x(name string, subnet *net.IPNet, vlan int) string {
+	return fmt.Sprintf(`
+resource "unifi_network" "test" {
+	name    = "%[1]s"
+	purpose = "corporate"
+	subnet  = "%[2]s"
+	vlan_id = %[3]d
+}
+
+resource "unifi_radius_profile" "test" {
+	name = "%[1]s"
+
+	auth_server {
+		ip      = "192.168.1.1"
+		xsecret = "securepw1"
+	}
+}
+
+resource "unifi_setting_global_switch" "test" {
+	stp_version            = "rstp"
+	dhcp_snoop             = true
+	dot1x_portctrl_enabled = true
+
+	radius_profile_id         = unifi_radius_profile.test.id
+	dot1x_fallback_network_id = unifi_network.test.id
+
+	switch_exclusions = ["00:00:5e:00:53:10"]
+}
+`, name, subnet, vlan)
+}
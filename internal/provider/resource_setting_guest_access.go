@@ -0,0 +1,773 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/paultyng/go-unifi/unifi"
+)
+
+var (
+	guestAccessColorRegexp    = regexp.MustCompile("^#[a-zA-Z0-9]{6}$|^#[a-zA-Z0-9]{3}$")
+	guestAccessExpireRegexp   = regexp.MustCompile(`^([\d]+|custom)$`)
+	guestAccessHostnameRegexp = regexp.MustCompile("^[a-zA-Z0-9.-]+$")
+	guestAccessLanguageRegexp = regexp.MustCompile("^[a-z]{2}(_[A-Z]{2})*$")
+
+	validateGuestAccessColor = validation.StringMatch(guestAccessColorRegexp, "must be a hex color such as #ffffff")
+)
+
+// guestAccessPaymentCredentials lists the payment attributes each gateway requires.
+var guestAccessPaymentCredentials = map[string][]string{
+	"paypal":          {"paypal_username", "paypal_password", "paypal_signature"},
+	"stripe":          {"stripe_api_key"},
+	"authorize":       {"authorize_login_id", "authorize_transaction_key"},
+	"quickpay":        {"quickpay_agreement_id", "quickpay_api_key", "quickpay_merchant_id"},
+	"merchantwarrior": {"merchantwarrior_api_key", "merchantwarrior_api_passphrase", "merchantwarrior_merchant_uuid"},
+	"ippay":           {"ippay_terminal_id"},
+}
+
+func resourceSettingGuestAccess() *schema.Resource {
+	return &schema.Resource{
+		Description: "`unifi_setting_guest_access` manages the guest access (hotspot portal) settings for a unifi site.",
+
+		CreateContext: resourceSettingGuestAccessUpsert,
+		ReadContext:   resourceSettingGuestAccessRead,
+		UpdateContext: resourceSettingGuestAccessUpsert,
+		DeleteContext: schema.NoopContext,
+		Importer: &schema.ResourceImporter{
+			StateContext: importSiteAndID,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of the settings.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"site": {
+				Description: "The name of the site to associate the settings with.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"auth": {
+				Description:  "The authentication method for guests, valid values are `none`, `hotspot`, `facebook_wifi` and `custom`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"none", "hotspot", "facebook_wifi", "custom"}, false),
+			},
+			"portal_enabled": {
+				Description: "Whether the guest portal is enabled.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"portal_hostname": {
+				Description:  "The hostname the portal is served on instead of the IP address of the gateway.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(guestAccessHostnameRegexp, "must be a valid hostname"),
+			},
+			"template_engine": {
+				Description:  "The template engine of the portal, valid values are `jsp` and `angular`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"jsp", "angular"}, false),
+			},
+			"expire": {
+				Description:  "The number of minutes a guest authorization is valid for, or `custom` to use `expire_number` and `expire_unit`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringMatch(guestAccessExpireRegexp, "must be a number of minutes or custom"),
+			},
+			"expire_number": {
+				Description:  "The number of `expire_unit`s a guest authorization is valid for when `expire` is `custom`.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 1000000),
+			},
+			"expire_unit": {
+				Description:  "The unit of `expire_number` in minutes, valid values are `1`, `60` and `1440`.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntInSlice([]int{1, 60, 1440}),
+			},
+			"password_enabled": {
+				Description: "Whether guests authenticate with a shared password.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"password": {
+				Description: "The shared password for guests.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"voucher_enabled": {
+				Description: "Whether guests authenticate with vouchers.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"radius": {
+				Description: "Authenticate guests against a RADIUS profile.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"profile_id": {
+							Description: "The ID of the RADIUS profile to authenticate against.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"auth_type": {
+							Description:  "The RADIUS authentication protocol, valid values are `chap` and `mschapv2`.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "chap",
+							ValidateFunc: validation.StringInSlice([]string{"chap", "mschapv2"}, false),
+						},
+						"disconnect_enabled": {
+							Description: "Whether RADIUS disconnect messages are accepted.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+						},
+						"disconnect_port": {
+							Description:  "The port RADIUS disconnect messages are accepted on.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      3799,
+							ValidateFunc: validation.IsPortNumber,
+						},
+					},
+				},
+			},
+			"facebook_wifi": {
+				Description: "The Facebook Wi-Fi gateway guests authenticate with, required when `auth` is `facebook_wifi`.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"gateway_id": {
+							Description: "The ID of the Facebook Wi-Fi gateway.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"gateway_name": {
+							Description: "The name of the Facebook Wi-Fi gateway.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"gateway_secret": {
+							Description: "The secret of the Facebook Wi-Fi gateway.",
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+						},
+						"block_https": {
+							Description: "Whether HTTPS traffic is blocked until guests are authorized.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"payment": {
+				Description: "Let guests pay for access through a payment gateway, only supported when `auth` is `hotspot`. " +
+					"The credentials of the selected gateway are required.",
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"gateway": {
+							Description:  "The payment gateway, valid values are `paypal`, `stripe`, `authorize`, `quickpay`, `merchantwarrior` and `ippay`.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"paypal", "stripe", "authorize", "quickpay", "merchantwarrior", "ippay"}, false),
+						},
+						"use_sandbox": {
+							Description: "Whether payments go to the sandbox or test mode of the gateway, not supported by `stripe`.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+						},
+						"paypal_username": {
+							Description: "The PayPal API username.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+						},
+						"paypal_password": {
+							Description: "The PayPal API password.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+						},
+						"paypal_signature": {
+							Description: "The PayPal API signature.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+						},
+						"stripe_api_key": {
+							Description: "The Stripe API key.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+						},
+						"authorize_login_id": {
+							Description: "The Authorize.Net API login ID.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+						},
+						"authorize_transaction_key": {
+							Description: "The Authorize.Net transaction key.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+						},
+						"quickpay_agreement_id": {
+							Description: "The QuickPay agreement ID.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+						},
+						"quickpay_api_key": {
+							Description: "The QuickPay API key.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+						},
+						"quickpay_merchant_id": {
+							Description: "The QuickPay merchant ID.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+						},
+						"merchantwarrior_api_key": {
+							Description: "The Merchant Warrior API key.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+						},
+						"merchantwarrior_api_passphrase": {
+							Description: "The Merchant Warrior API passphrase.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+						},
+						"merchantwarrior_merchant_uuid": {
+							Description: "The Merchant Warrior merchant UUID.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+						},
+						"ippay_terminal_id": {
+							Description: "The IPpay terminal ID.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+						},
+					},
+				},
+			},
+			"branding": {
+				Description: "Customization of the look of the portal. Removing the block reverts to the default portal.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"title": {
+							Description: "The title of the portal.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"welcome_text": {
+							Description: "The welcome text shown on the portal.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"welcome_text_position": {
+							Description:  "The position of the welcome text, valid values are `under_logo` and `above_boxes`.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "under_logo",
+							ValidateFunc: validation.StringInSlice([]string{"under_logo", "above_boxes"}, false),
+						},
+						"tos": {
+							Description: "The terms of service guests have to accept.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+						},
+						"languages": {
+							Description: "The languages the portal is offered in, for example `en` or `pt_BR`.",
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringMatch(guestAccessLanguageRegexp, "must be a language code such as en or pt_BR"),
+							},
+						},
+						"bg_color": {
+							Description:  "The background color of the portal.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validateGuestAccessColor,
+						},
+						"text_color": {
+							Description:  "The text color of the portal.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validateGuestAccessColor,
+						},
+						"link_color": {
+							Description:  "The link color of the portal.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validateGuestAccessColor,
+						},
+						"box_color": {
+							Description:  "The color of the login box.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validateGuestAccessColor,
+						},
+						"box_text_color": {
+							Description:  "The text color of the login box.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validateGuestAccessColor,
+						},
+						"box_link_color": {
+							Description:  "The link color of the login box.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validateGuestAccessColor,
+						},
+						"box_opacity": {
+							Description:  "The opacity of the login box in percent.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1, 100),
+						},
+						"button_color": {
+							Description:  "The color of the buttons.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validateGuestAccessColor,
+						},
+						"button_text_color": {
+							Description:  "The text color of the buttons.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validateGuestAccessColor,
+						},
+					},
+				},
+			},
+			"redirect": {
+				Description: "Redirect guests to a URL after they are authorized.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"url": {
+							Description:  "The URL guests are redirected to.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+						"use_https": {
+							Description: "Whether the portal itself is served over HTTPS.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+						},
+						"to_https": {
+							Description: "Whether HTTP requests of unauthorized guests are redirected to HTTPS.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"restrictions": {
+				Description: "Network access of guests before and after authorization.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_subnet": {
+							Description:  "A subnet guests can reach before they are authorized.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: cidrValidate,
+						},
+						"restricted_subnet": {
+							Description:  "A subnet guests can not reach, even when authorized.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: cidrValidate,
+						},
+						"dns_servers": {
+							Description: "The DNS servers guests are restricted to.",
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.IsIPv4Address,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceSettingGuestAccessUpdateResourceData(d *schema.ResourceData, setting *unifi.SettingGuestAccess) error {
+	setting.Auth = d.Get("auth").(string)
+	setting.PortalEnabled = d.Get("portal_enabled").(bool)
+	setting.PortalHostname = d.Get("portal_hostname").(string)
+	setting.PortalUseHostname = setting.PortalHostname != ""
+	setting.TemplateEngine = d.Get("template_engine").(string)
+	setting.Expire = d.Get("expire").(string)
+	setting.ExpireNumber = d.Get("expire_number").(int)
+	setting.ExpireUnit = d.Get("expire_unit").(int)
+	setting.PasswordEnabled = d.Get("password_enabled").(bool)
+	setting.XPassword = d.Get("password").(string)
+	setting.VoucherEnabled = d.Get("voucher_enabled").(bool)
+
+	if setting.PasswordEnabled && setting.XPassword == "" {
+		return fmt.Errorf("password is required when password_enabled is true")
+	}
+
+	setting.RADIUSEnabled = false
+	if v, ok := d.GetOk("radius.0"); ok {
+		radius := v.(map[string]interface{})
+		setting.RADIUSEnabled = true
+		setting.RADIUSProfileID = radius["profile_id"].(string)
+		setting.RADIUSAuthType = radius["auth_type"].(string)
+		setting.RADIUSDisconnectEnabled = radius["disconnect_enabled"].(bool)
+		setting.RADIUSDisconnectPort = radius["disconnect_port"].(int)
+	}
+
+	if v, ok := d.GetOk("facebook_wifi.0"); ok {
+		facebookWifi := v.(map[string]interface{})
+		setting.FacebookWifiGwID = facebookWifi["gateway_id"].(string)
+		setting.FacebookWifiGwName = facebookWifi["gateway_name"].(string)
+		setting.XFacebookWifiGwSecret = facebookWifi["gateway_secret"].(string)
+		setting.FacebookWifiBlockHttps = facebookWifi["block_https"].(bool)
+	} else if setting.Auth == "facebook_wifi" {
+		return fmt.Errorf("facebook_wifi is required when auth is facebook_wifi")
+	}
+
+	setting.PaymentEnabled = false
+	if v, ok := d.GetOk("payment.0"); ok {
+		if setting.Auth != "hotspot" {
+			return fmt.Errorf("payment is only supported when auth is hotspot")
+		}
+
+		payment := v.(map[string]interface{})
+		gateway := payment["gateway"].(string)
+		for _, k := range guestAccessPaymentCredentials[gateway] {
+			if payment[k].(string) == "" {
+				return fmt.Errorf("payment %s is required for the %s gateway", k, gateway)
+			}
+		}
+
+		useSandbox := payment["use_sandbox"].(bool)
+		setting.PaymentEnabled = true
+		setting.Gateway = gateway
+		setting.PaypalUseSandbox = gateway == "paypal" && useSandbox
+		setting.AuthorizeUseSandbox = gateway == "authorize" && useSandbox
+		setting.QuickpayTestmode = gateway == "quickpay" && useSandbox
+		setting.MerchantwarriorUseSandbox = gateway == "merchantwarrior" && useSandbox
+		setting.IPpayUseSandbox = gateway == "ippay" && useSandbox
+		setting.XPaypalUsername = payment["paypal_username"].(string)
+		setting.XPaypalPassword = payment["paypal_password"].(string)
+		setting.XPaypalSignature = payment["paypal_signature"].(string)
+		setting.XStripeApiKey = payment["stripe_api_key"].(string)
+		setting.XAuthorizeLoginid = payment["authorize_login_id"].(string)
+		setting.XAuthorizeTransactionkey = payment["authorize_transaction_key"].(string)
+		setting.XQuickpayAgreementid = payment["quickpay_agreement_id"].(string)
+		setting.XQuickpayApikey = payment["quickpay_api_key"].(string)
+		setting.XQuickpayMerchantid = payment["quickpay_merchant_id"].(string)
+		setting.XMerchantwarriorApikey = payment["merchantwarrior_api_key"].(string)
+		setting.XMerchantwarriorApipassphrase = payment["merchantwarrior_api_passphrase"].(string)
+		setting.XMerchantwarriorMerchantuuid = payment["merchantwarrior_merchant_uuid"].(string)
+		setting.XIPpayTerminalid = payment["ippay_terminal_id"].(string)
+	}
+
+	setting.PortalCustomized = false
+	if v, ok := d.GetOk("branding.0"); ok {
+		branding := v.(map[string]interface{})
+		languages, err := listToStringSlice(branding["languages"].([]interface{}))
+		if err != nil {
+			return fmt.Errorf("unable to convert branding languages to string slice: %w", err)
+		}
+
+		setting.PortalCustomized = true
+		setting.PortalCustomizedTitle = branding["title"].(string)
+		setting.PortalCustomizedWelcomeText = branding["welcome_text"].(string)
+		setting.PortalCustomizedWelcomeTextEnabled = setting.PortalCustomizedWelcomeText != ""
+		setting.PortalCustomizedWelcomeTextPosition = branding["welcome_text_position"].(string)
+		setting.PortalCustomizedTos = branding["tos"].(string)
+		setting.PortalCustomizedTosEnabled = setting.PortalCustomizedTos != ""
+		setting.PortalCustomizedLanguages = languages
+		setting.PortalCustomizedBgColor = branding["bg_color"].(string)
+		setting.PortalCustomizedTextColor = branding["text_color"].(string)
+		setting.PortalCustomizedLinkColor = branding["link_color"].(string)
+		setting.PortalCustomizedBoxColor = branding["box_color"].(string)
+		setting.PortalCustomizedBoxTextColor = branding["box_text_color"].(string)
+		setting.PortalCustomizedBoxLinkColor = branding["box_link_color"].(string)
+		setting.PortalCustomizedBoxOpacity = branding["box_opacity"].(int)
+		setting.PortalCustomizedButtonColor = branding["button_color"].(string)
+		setting.PortalCustomizedButtonTextColor = branding["button_text_color"].(string)
+	}
+
+	setting.RedirectEnabled = false
+	setting.RedirectUrl = ""
+	setting.RedirectHttps = false
+	setting.RedirectToHttps = false
+	if v, ok := d.GetOk("redirect.0"); ok {
+		redirect := v.(map[string]interface{})
+		setting.RedirectEnabled = true
+		setting.RedirectUrl = redirect["url"].(string)
+		setting.RedirectHttps = redirect["use_https"].(bool)
+		setting.RedirectToHttps = redirect["to_https"].(bool)
+	}
+
+	setting.AllowedSubnet = ""
+	setting.RestrictedSubnet = ""
+	setting.RestrictedDNSEnabled = false
+	setting.RestrictedDNSServers = nil
+	if v, ok := d.GetOk("restrictions.0"); ok {
+		restrictions := v.(map[string]interface{})
+		dnsServers, err := listToStringSlice(restrictions["dns_servers"].([]interface{}))
+		if err != nil {
+			return fmt.Errorf("unable to convert restrictions dns_servers to string slice: %w", err)
+		}
+
+		setting.AllowedSubnet = restrictions["allowed_subnet"].(string)
+		setting.RestrictedSubnet = restrictions["restricted_subnet"].(string)
+		setting.RestrictedDNSEnabled = len(dnsServers) > 0
+		setting.RestrictedDNSServers = dnsServers
+	}
+
+	return nil
+}
+
+// resourceSettingGuestAccessClearedFields returns the settings that are empty after removing their arguments.
+// They omit empty values, so they are sent explicitly to clear them on the controller.
+func resourceSettingGuestAccessClearedFields(setting *unifi.SettingGuestAccess) map[string]interface{} {
+	fields := map[string]interface{}{}
+	for k, v := range map[string]string{
+		"x_password":         setting.XPassword,
+		"redirect_url":       setting.RedirectUrl,
+		"allowed_subnet_":    setting.AllowedSubnet,
+		"restricted_subnet_": setting.RestrictedSubnet,
+	} {
+		if v == "" {
+			fields[k] = ""
+		}
+	}
+	if len(setting.RestrictedDNSServers) == 0 {
+		fields["restricted_dns_servers"] = []string{}
+	}
+	return fields
+}
+
+func resourceSettingGuestAccessUpsert(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+
+	req, err := c.c.GetSettingGuestAccess(ctx, site)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = resourceSettingGuestAccessUpdateResourceData(d, req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := c.c.UpdateSettingGuestAccessFields(ctx, site, req, resourceSettingGuestAccessClearedFields(req))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resp.ID)
+	return resourceSettingGuestAccessSetResourceData(resp, d, site)
+}
+
+func resourceSettingGuestAccessSetResourceData(resp *unifi.SettingGuestAccess, d *schema.ResourceData, site string) diag.Diagnostics {
+	d.Set("site", site)
+	d.Set("auth", resp.Auth)
+	d.Set("portal_enabled", resp.PortalEnabled)
+	d.Set("template_engine", resp.TemplateEngine)
+	d.Set("expire", resp.Expire)
+	d.Set("expire_number", resp.ExpireNumber)
+	d.Set("expire_unit", resp.ExpireUnit)
+	d.Set("password_enabled", resp.PasswordEnabled)
+	d.Set("password", resp.XPassword)
+	d.Set("voucher_enabled", resp.VoucherEnabled)
+
+	portalHostname := ""
+	if resp.PortalUseHostname {
+		portalHostname = resp.PortalHostname
+	}
+	d.Set("portal_hostname", portalHostname)
+
+	radius := []interface{}{}
+	if resp.RADIUSEnabled {
+		radius = append(radius, map[string]interface{}{
+			"profile_id":         resp.RADIUSProfileID,
+			"auth_type":          resp.RADIUSAuthType,
+			"disconnect_enabled": resp.RADIUSDisconnectEnabled,
+			"disconnect_port":    resp.RADIUSDisconnectPort,
+		})
+	}
+	d.Set("radius", radius)
+
+	facebookWifi := []interface{}{}
+	if resp.Auth == "facebook_wifi" {
+		facebookWifi = append(facebookWifi, map[string]interface{}{
+			"gateway_id":     resp.FacebookWifiGwID,
+			"gateway_name":   resp.FacebookWifiGwName,
+			"gateway_secret": resp.XFacebookWifiGwSecret,
+			"block_https":    resp.FacebookWifiBlockHttps,
+		})
+	}
+	d.Set("facebook_wifi", facebookWifi)
+
+	payment := []interface{}{}
+	if resp.PaymentEnabled {
+		payment = append(payment, map[string]interface{}{
+			"gateway": resp.Gateway,
+			"use_sandbox": resp.PaypalUseSandbox || resp.AuthorizeUseSandbox || resp.QuickpayTestmode ||
+				resp.MerchantwarriorUseSandbox || resp.IPpayUseSandbox,
+			"paypal_username":                resp.XPaypalUsername,
+			"paypal_password":                resp.XPaypalPassword,
+			"paypal_signature":               resp.XPaypalSignature,
+			"stripe_api_key":                 resp.XStripeApiKey,
+			"authorize_login_id":             resp.XAuthorizeLoginid,
+			"authorize_transaction_key":      resp.XAuthorizeTransactionkey,
+			"quickpay_agreement_id":          resp.XQuickpayAgreementid,
+			"quickpay_api_key":               resp.XQuickpayApikey,
+			"quickpay_merchant_id":           resp.XQuickpayMerchantid,
+			"merchantwarrior_api_key":        resp.XMerchantwarriorApikey,
+			"merchantwarrior_api_passphrase": resp.XMerchantwarriorApipassphrase,
+			"merchantwarrior_merchant_uuid":  resp.XMerchantwarriorMerchantuuid,
+			"ippay_terminal_id":              resp.XIPpayTerminalid,
+		})
+	}
+	d.Set("payment", payment)
+
+	branding := []interface{}{}
+	if resp.PortalCustomized {
+		welcomeText := ""
+		if resp.PortalCustomizedWelcomeTextEnabled {
+			welcomeText = resp.PortalCustomizedWelcomeText
+		}
+		tos := ""
+		if resp.PortalCustomizedTosEnabled {
+			tos = resp.PortalCustomizedTos
+		}
+
+		branding = append(branding, map[string]interface{}{
+			"title":                 resp.PortalCustomizedTitle,
+			"welcome_text":          welcomeText,
+			"welcome_text_position": resp.PortalCustomizedWelcomeTextPosition,
+			"tos":                   tos,
+			"languages":             resp.PortalCustomizedLanguages,
+			"bg_color":              resp.PortalCustomizedBgColor,
+			"text_color":            resp.PortalCustomizedTextColor,
+			"link_color":            resp.PortalCustomizedLinkColor,
+			"box_color":             resp.PortalCustomizedBoxColor,
+			"box_text_color":        resp.PortalCustomizedBoxTextColor,
+			"box_link_color":        resp.PortalCustomizedBoxLinkColor,
+			"box_opacity":           resp.PortalCustomizedBoxOpacity,
+			"button_color":          resp.PortalCustomizedButtonColor,
+			"button_text_color":     resp.PortalCustomizedButtonTextColor,
+		})
+	}
+	d.Set("branding", branding)
+
+	redirect := []interface{}{}
+	if resp.RedirectEnabled {
+		redirect = append(redirect, map[string]interface{}{
+			"url":       resp.RedirectUrl,
+			"use_https": resp.RedirectHttps,
+			"to_https":  resp.RedirectToHttps,
+		})
+	}
+	d.Set("redirect", redirect)
+
+	restrictions := []interface{}{}
+	if resp.AllowedSubnet != "" || resp.RestrictedSubnet != "" || resp.RestrictedDNSEnabled {
+		dnsServers := []string{}
+		if resp.RestrictedDNSEnabled {
+			dnsServers = resp.RestrictedDNSServers
+		}
+
+		restrictions = append(restrictions, map[string]interface{}{
+			"allowed_subnet":    resp.AllowedSubnet,
+			"restricted_subnet": resp.RestrictedSubnet,
+			"dns_servers":       dnsServers,
+		})
+	}
+	d.Set("restrictions", restrictions)
+
+	return nil
+}
+
+func resourceSettingGuestAccessRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+
+	resp, err := c.c.GetSettingGuestAccess(ctx, site)
+	if _, ok := err.(*unifi.NotFoundError); ok {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceSettingGuestAccessSetResourceData(resp, d, site)
+}
@@ -0,0 +1,229 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/paultyng/go-unifi/unifi"
+)
+
+var settingGuestAccessLock = sync.Mutex{}
+
+// guestAccessStubClient sends the guest access settings requests to a go-unifi client.
+type guestAccessStubClient struct {
+	unifiClient
+	inner *unifi.Client
+}
+
+func (c *guestAccessStubClient) GetSettingGuestAccess(ctx context.Context, site string) (*unifi.SettingGuestAccess, error) {
+	return c.inner.GetSettingGuestAccess(ctx, site)
+}
+
+func (c *guestAccessStubClient) UpdateSettingGuestAccessFields(ctx context.Context, site string, d *unifi.SettingGuestAccess, fields map[string]interface{}) (*unifi.SettingGuestAccess, error) {
+	return c.inner.UpdateSettingGuestAccessFields(ctx, site, d, fields)
+}
+
+func TestSettingGuestAccess_clearsRemovedSettings(t *testing.T) {
+	var putBody map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/s/default/get/setting/guest_access":
+			_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[{"_id":"id","key":"guest_access","auth":"hotspot",` +
+				`"password_enabled":true,"x_password":"secret","allowed_subnet_":"10.0.0.0/8","restricted_subnet_":"192.168.0.0/16",` +
+				`"restricted_dns_enabled":true,"restricted_dns_servers":["10.0.0.1"]}]}`))
+		case r.Method == "PUT" && r.URL.Path == "/s/default/set/setting/guest_access":
+			if err := json.NewDecoder(r.Body).Decode(&putBody); err != nil {
+				t.Fatal(err)
+			}
+			resp, _ := json.Marshal(map[string]interface{}{
+				"meta": map[string]interface{}{"rc": "ok"},
+				"data": []interface{}{putBody},
+			})
+			_, _ = w.Write(resp)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	inner := &unifi.Client{}
+	if err := inner.SetBaseURL(srv.URL); err != nil {
+		t.Fatal(err)
+	}
+	if err := inner.SetHTTPClient(srv.Client()); err != nil {
+		t.Fatal(err)
+	}
+
+	// the restrictions block and the password are removed from the configuration
+	d := schema.TestResourceDataRaw(t, resourceSettingGuestAccess().Schema, map[string]interface{}{
+		"auth":             "hotspot",
+		"password_enabled": false,
+	})
+	diags := resourceSettingGuestAccessUpsert(context.Background(), d, &client{
+		c:    &guestAccessStubClient{inner: inner},
+		site: "default",
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	for k, expected := range map[string]interface{}{
+		"x_password":         "",
+		"allowed_subnet_":    "",
+		"restricted_subnet_": "",
+	} {
+		if v, ok := putBody[k]; !ok || v != expected {
+			t.Errorf("expected %s to be sent as %q, got %v", k, expected, v)
+		}
+	}
+	if v, ok := putBody["restricted_dns_servers"].([]interface{}); !ok || len(v) != 0 {
+		t.Errorf("expected restricted_dns_servers to be sent empty, got %v", putBody["restricted_dns_servers"])
+	}
+	if n := len(d.Get("restrictions").([]interface{})); n != 0 {
+		t.Errorf("expected no restrictions block, got %d", n)
+	}
+}
+
+func TestAccSettingGuestAccess_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			preCheck(t)
+			settingGuestAccessLock.Lock()
+			t.Cleanup(func() {
+				settingGuestAccessLock.Unlock()
+			})
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSettingGuestAccessConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_setting_guest_access.test", "auth", "hotspot"),
+					resource.TestCheckResourceAttr("unifi_setting_guest_access.test", "password_enabled", "true"),
+					resource.TestCheckResourceAttr("unifi_setting_guest_access.test", "branding.#", "0"),
+				),
+			},
+			importStep("unifi_setting_guest_access.test"),
+			{
+				Config: testAccSettingGuestAccessConfig_blocks(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_setting_guest_access.test", "branding.0.title", "tfacc portal"),
+					resource.TestCheckResourceAttr("unifi_setting_guest_access.test", "branding.0.bg_color", "#1a1a1a"),
+					resource.TestCheckResourceAttr("unifi_setting_guest_access.test", "redirect.0.url", "https://example.com/"),
+					resource.TestCheckResourceAttr("unifi_setting_guest_access.test", "restrictions.0.dns_servers.#", "2"),
+				),
+			},
+			importStep("unifi_setting_guest_access.test"),
+			{
+				Config: testAccSettingGuestAccessConfig_payment(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_setting_guest_access.test", "payment.0.gateway", "stripe"),
+					resource.TestCheckResourceAttr("unifi_setting_guest_access.test", "payment.0.stripe_api_key", "sk_test_tfacc"),
+				),
+			},
+			importStep("unifi_setting_guest_access.test"),
+			{
+				Config: testAccSettingGuestAccessConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_setting_guest_access.test", "payment.#", "0"),
+					resource.TestCheckResourceAttr("unifi_setting_guest_access.test", "branding.#", "0"),
+					resource.TestCheckResourceAttr("unifi_setting_guest_access.test", "redirect.#", "0"),
+					resource.TestCheckResourceAttr("unifi_setting_guest_access.test", "restrictions.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSettingGuestAccess_facebookWifiRequired(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			preCheck(t)
+			settingGuestAccessLock.Lock()
+			t.Cleanup(func() {
+				settingGuestAccessLock.Unlock()
+			})
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "unifi_setting_guest_access" "test" {
+	auth           = "facebook_wifi"
+	portal_enabled = true
+}
+`,
+				ExpectError: regexp.MustCompile("facebook_wifi is required when auth is facebook_wifi"),
+			},
+		},
+	})
+}
+
+func testAccSettingGuestAccessConfig_basic() string {
+	return `
+resource "unifi_setting_guest_access" "test" {
+	auth           = "hotspot"
+	portal_enabled = true
+	expire         = "480"
+
+	password_enabled = true
+	password         = "tfacc-guest"
+}
+`
+}
+
+func testAccSettingGuestAccessConfig_blocks() string {
+	return `
+resource "unifi_setting_guest_access" "test" {
+	auth           = "hotspot"
+	portal_enabled = true
+	expire         = "480"
+
+	password_enabled = true
+	password         = "tfacc-guest"
+
+	branding {
+		title        = "tfacc portal"
+		welcome_text = "Welcome"
+		tos          = "Be nice."
+		languages    = ["en"]
+		bg_color     = "#1a1a1a"
+	}
+
+	redirect {
+		url = "https://example.com/"
+	}
+
+	restrictions {
+		restricted_subnet = "192.168.0.0/16"
+		dns_servers       = ["1.1.1.1", "1.0.0.1"]
+	}
+}
+`
+}
+
+func testAccSettingGuestAccessConfig_payment() string {
+	return `
+resource "unifi_setting_guest_access" "test" {
+	auth           = "hotspot"
+	portal_enabled = true
+	expire         = "480"
+
+	password_enabled = false
+
+	payment {
+		gateway        = "stripe"
+		stripe_api_key = "sk_test_tfacc"
+	}
+}
+`
+}
@@ -0,0 +1,526 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/paultyng/go-unifi/unifi"
+)
+
+var (
+	ipsDomainRegexp = regexp.MustCompile("^[a-zA-Z0-9.-]+$")
+
+	ipsCategories = []string{
+		"emerging-activex", "emerging-attackresponse", "botcc", "emerging-chat", "ciarmy", "compromised",
+		"emerging-dns", "emerging-dos", "dshield", "emerging-exploit", "emerging-ftp", "emerging-games",
+		"emerging-icmp", "emerging-icmpinfo", "emerging-imap", "emerging-inappropriate", "emerging-info",
+		"emerging-malware", "emerging-misc", "emerging-mobile", "emerging-netbios", "emerging-p2p",
+		"emerging-policy", "emerging-pop3", "emerging-rpc", "emerging-scada", "emerging-scan",
+		"emerging-shellcode", "emerging-smtp", "emerging-snmp", "emerging-sql", "emerging-telnet",
+		"emerging-tftp", "tor", "emerging-trojan", "emerging-useragent", "emerging-voip", "emerging-webapps",
+		"emerging-webclient", "emerging-webserver", "emerging-worm",
+	}
+)
+
+// ipsTrackingSchema is the schema of the traffic matched by suppression alerts and whitelists.
+func ipsTrackingSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"direction": {
+			Description:  "The direction of the traffic to match, valid values are `both`, `src` and `dest`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "both",
+			ValidateFunc: validation.StringInSlice([]string{"both", "src", "dest"}, false),
+		},
+		"mode": {
+			Description:  "The type of `value`, valid values are `ip`, `subnet` and `network`.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice([]string{"ip", "subnet", "network"}, false),
+		},
+		"value": {
+			Description: "The IP address, the subnet in CIDR notation or the ID of the network to match.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+	}
+}
+
+func resourceSettingIPS() *schema.Resource {
+	return &schema.Resource{
+		Description: "`unifi_setting_ips` manages the threat management (IDS/IPS), DNS filtering, ad blocking and " +
+			"honeypot settings for a unifi site.",
+
+		CreateContext: resourceSettingIPSUpsert,
+		ReadContext:   resourceSettingIPSRead,
+		UpdateContext: resourceSettingIPSUpsert,
+		DeleteContext: schema.NoopContext,
+		Importer: &schema.ResourceImporter{
+			StateContext: importSiteAndID,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of the settings.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"site": {
+				Description: "The name of the site to associate the settings with.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"ips_mode": {
+				Description:  "The threat management mode, valid values are `ids`, `ips`, `ipsInline` and `disabled`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ids", "ips", "ipsInline", "disabled"}, false),
+			},
+			"enabled_categories": {
+				Description: "The threat categories detected by the IDS/IPS, for example `emerging-malware` or `tor`.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(ipsCategories, false),
+				},
+			},
+			"restrict_ip_addresses": {
+				Description: "Whether traffic to IP addresses with a bad reputation is blocked.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"restrict_tor": {
+				Description: "Whether traffic to the Tor network is blocked.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"restrict_torrents": {
+				Description: "Whether BitTorrent traffic is blocked.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"ad_blocking": {
+				Description: "Block advertisements on networks. Removing the block disables ad blocking.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"network_ids": {
+							Description: "The IDs of the networks to block advertisements on.",
+							Type:        schema.TypeSet,
+							Required:    true,
+							MinItems:    1,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"dns_filter": {
+				Description: "A DNS filter of a network. Without any filters DNS filtering is disabled.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"network_id": {
+							Description: "The ID of the network to filter.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"filter": {
+							Description:  "The predefined filter, valid values are `none`, `work` and `family`.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "none",
+							ValidateFunc: validation.StringInSlice([]string{"none", "work", "family"}, false),
+						},
+						"name": {
+							Description: "The name of the filter.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"description": {
+							Description: "The description of the filter.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"version": {
+							Description:  "The IP version of the filter, valid values are `v4` and `v6`.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "v4",
+							ValidateFunc: validation.StringInSlice([]string{"v4", "v6"}, false),
+						},
+						"allowed_sites": {
+							Description: "Domains that are always allowed.",
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringMatch(ipsDomainRegexp, "must be a domain name"),
+							},
+						},
+						"blocked_sites": {
+							Description: "Domains that are always blocked.",
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringMatch(ipsDomainRegexp, "must be a domain name"),
+							},
+						},
+						"blocked_tlds": {
+							Description: "Top level domains that are blocked, for example `xyz`.",
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringMatch(ipsDomainRegexp, "must be a top level domain"),
+							},
+						},
+					},
+				},
+			},
+			"honeypot": {
+				Description: "A honeypot on a network. Without any honeypots the feature is disabled.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"network_id": {
+							Description: "The ID of the network of the honeypot.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"ip_address": {
+							Description:  "The IP address of the honeypot.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsIPAddress,
+						},
+						"version": {
+							Description:  "The IP version of `ip_address`, valid values are `v4` and `v6`.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "v4",
+							ValidateFunc: validation.StringInSlice([]string{"v4", "v6"}, false),
+						},
+					},
+				},
+			},
+			"suppression": {
+				Description: "Signatures and traffic excluded from threat detection.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alert": {
+							Description: "A signature that is suppressed.",
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"category": {
+										Description:  "The category of the signature.",
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(ipsCategories, false),
+									},
+									"signature": {
+										Description: "The name of the signature.",
+										Type:        schema.TypeString,
+										Required:    true,
+									},
+									"id": {
+										Description: "The ID of the signature.",
+										Type:        schema.TypeInt,
+										Required:    true,
+									},
+									"gid": {
+										Description: "The generator ID of the signature.",
+										Type:        schema.TypeInt,
+										Optional:    true,
+										Default:     1,
+									},
+									"type": {
+										Description:  "Whether the signature is suppressed for `all` traffic or only the `track`ed traffic.",
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "all",
+										ValidateFunc: validation.StringInSlice([]string{"all", "track"}, false),
+									},
+									"tracking": {
+										Description: "The traffic the signature is suppressed for when `type` is `track`.",
+										Type:        schema.TypeList,
+										Optional:    true,
+										Elem: &schema.Resource{
+											Schema: ipsTrackingSchema(),
+										},
+									},
+								},
+							},
+						},
+						"whitelist": {
+							Description: "Traffic that is never flagged as a threat.",
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem: &schema.Resource{
+								Schema: ipsTrackingSchema(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func listToIPSTracking(list []interface{}) []unifi.SettingIpsTracking {
+	tracking := make([]unifi.SettingIpsTracking, 0, len(list))
+	for _, v := range list {
+		t := v.(map[string]interface{})
+		tracking = append(tracking, unifi.SettingIpsTracking{
+			Direction: t["direction"].(string),
+			Mode:      t["mode"].(string),
+			Value:     t["value"].(string),
+		})
+	}
+	return tracking
+}
+
+func listFromIPSTracking(tracking []unifi.SettingIpsTracking) []interface{} {
+	list := make([]interface{}, 0, len(tracking))
+	for _, t := range tracking {
+		list = append(list, map[string]interface{}{
+			"direction": t.Direction,
+			"mode":      t.Mode,
+			"value":     t.Value,
+		})
+	}
+	return list
+}
+
+func resourceSettingIPSUpdateResourceData(d *schema.ResourceData, setting *unifi.SettingIps) error {
+	categories, err := setToStringSlice(d.Get("enabled_categories").(*schema.Set))
+	if err != nil {
+		return fmt.Errorf("unable to convert enabled_categories to string slice: %w", err)
+	}
+
+	setting.IPsMode = d.Get("ips_mode").(string)
+	setting.EnabledCategories = categories
+	setting.RestrictIPAddresses = d.Get("restrict_ip_addresses").(bool)
+	setting.RestrictTor = d.Get("restrict_tor").(bool)
+	setting.RestrictTorrents = d.Get("restrict_torrents").(bool)
+
+	setting.AdBlockingEnabled = false
+	setting.AdBlockingConfigurations = nil
+	if v, ok := d.GetOk("ad_blocking.0"); ok {
+		networkIDs, err := setToStringSlice(v.(map[string]interface{})["network_ids"].(*schema.Set))
+		if err != nil {
+			return fmt.Errorf("unable to convert ad_blocking network_ids to string slice: %w", err)
+		}
+
+		setting.AdBlockingEnabled = true
+		for _, id := range networkIDs {
+			setting.AdBlockingConfigurations = append(setting.AdBlockingConfigurations, unifi.SettingIpsAdBlockingConfigurations{
+				NetworkID: id,
+			})
+		}
+	}
+
+	setting.DNSFilters = nil
+	for i, v := range d.Get("dns_filter").([]interface{}) {
+		f := v.(map[string]interface{})
+		allowedSites, err := listToStringSlice(f["allowed_sites"].([]interface{}))
+		if err != nil {
+			return fmt.Errorf("unable to convert dns_filter %d allowed_sites to string slice: %w", i, err)
+		}
+		blockedSites, err := listToStringSlice(f["blocked_sites"].([]interface{}))
+		if err != nil {
+			return fmt.Errorf("unable to convert dns_filter %d blocked_sites to string slice: %w", i, err)
+		}
+		blockedTLDs, err := listToStringSlice(f["blocked_tlds"].([]interface{}))
+		if err != nil {
+			return fmt.Errorf("unable to convert dns_filter %d blocked_tlds to string slice: %w", i, err)
+		}
+
+		setting.DNSFilters = append(setting.DNSFilters, unifi.SettingIpsDNSFilters{
+			NetworkID:    f["network_id"].(string),
+			Filter:       f["filter"].(string),
+			Name:         f["name"].(string),
+			Description:  f["description"].(string),
+			Version:      f["version"].(string),
+			AllowedSites: allowedSites,
+			BlockedSites: blockedSites,
+			BlockedTld:   blockedTLDs,
+		})
+	}
+	setting.DNSFiltering = len(setting.DNSFilters) > 0
+
+	setting.Honeypot = nil
+	for _, v := range d.Get("honeypot").([]interface{}) {
+		h := v.(map[string]interface{})
+		setting.Honeypot = append(setting.Honeypot, unifi.SettingIpsHoneypot{
+			NetworkID: h["network_id"].(string),
+			IPAddress: h["ip_address"].(string),
+			Version:   h["version"].(string),
+		})
+	}
+	setting.HoneypotEnabled = len(setting.Honeypot) > 0
+
+	setting.Suppression = unifi.SettingIpsSuppression{}
+	if v, ok := d.GetOk("suppression.0"); ok {
+		suppression := v.(map[string]interface{})
+		for _, v := range suppression["alert"].([]interface{}) {
+			a := v.(map[string]interface{})
+			setting.Suppression.Alerts = append(setting.Suppression.Alerts, unifi.SettingIpsAlerts{
+				Category:  a["category"].(string),
+				Signature: a["signature"].(string),
+				ID:        a["id"].(int),
+				Gid:       a["gid"].(int),
+				Type:      a["type"].(string),
+				Tracking:  listToIPSTracking(a["tracking"].([]interface{})),
+			})
+		}
+		for _, w := range listToIPSTracking(suppression["whitelist"].([]interface{})) {
+			setting.Suppression.Whitelist = append(setting.Suppression.Whitelist, unifi.SettingIpsWhitelist(w))
+		}
+	}
+
+	return nil
+}
+
+func resourceSettingIPSUpsert(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+
+	req, err := c.c.GetSettingIps(ctx, site)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = resourceSettingIPSUpdateResourceData(d, req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := c.c.UpdateSettingIps(ctx, site, req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resp.ID)
+	return resourceSettingIPSSetResourceData(resp, d, site)
+}
+
+func resourceSettingIPSSetResourceData(resp *unifi.SettingIps, d *schema.ResourceData, site string) diag.Diagnostics {
+	d.Set("site", site)
+	d.Set("ips_mode", resp.IPsMode)
+	d.Set("enabled_categories", stringSliceToSet(resp.EnabledCategories))
+	d.Set("restrict_ip_addresses", resp.RestrictIPAddresses)
+	d.Set("restrict_tor", resp.RestrictTor)
+	d.Set("restrict_torrents", resp.RestrictTorrents)
+
+	adBlocking := []interface{}{}
+	if resp.AdBlockingEnabled {
+		networkIDs := []string{}
+		for _, c := range resp.AdBlockingConfigurations {
+			networkIDs = append(networkIDs, c.NetworkID)
+		}
+		adBlocking = append(adBlocking, map[string]interface{}{
+			"network_ids": stringSliceToSet(networkIDs),
+		})
+	}
+	d.Set("ad_blocking", adBlocking)
+
+	dnsFilters := []interface{}{}
+	if resp.DNSFiltering {
+		for _, f := range resp.DNSFilters {
+			dnsFilters = append(dnsFilters, map[string]interface{}{
+				"network_id":    f.NetworkID,
+				"filter":        f.Filter,
+				"name":          f.Name,
+				"description":   f.Description,
+				"version":       f.Version,
+				"allowed_sites": f.AllowedSites,
+				"blocked_sites": f.BlockedSites,
+				"blocked_tlds":  f.BlockedTld,
+			})
+		}
+	}
+	d.Set("dns_filter", dnsFilters)
+
+	honeypots := []interface{}{}
+	if resp.HoneypotEnabled {
+		for _, h := range resp.Honeypot {
+			honeypots = append(honeypots, map[string]interface{}{
+				"network_id": h.NetworkID,
+				"ip_address": h.IPAddress,
+				"version":    h.Version,
+			})
+		}
+	}
+	d.Set("honeypot", honeypots)
+
+	suppression := []interface{}{}
+	if len(resp.Suppression.Alerts) > 0 || len(resp.Suppression.Whitelist) > 0 {
+		alerts := []interface{}{}
+		for _, a := range resp.Suppression.Alerts {
+			alerts = append(alerts, map[string]interface{}{
+				"category":  a.Category,
+				"signature": a.Signature,
+				"id":        a.ID,
+				"gid":       a.Gid,
+				"type":      a.Type,
+				"tracking":  listFromIPSTracking(a.Tracking),
+			})
+		}
+		whitelist := []unifi.SettingIpsTracking{}
+		for _, w := range resp.Suppression.Whitelist {
+			whitelist = append(whitelist, unifi.SettingIpsTracking(w))
+		}
+		suppression = append(suppression, map[string]interface{}{
+			"alert":     alerts,
+			"whitelist": listFromIPSTracking(whitelist),
+		})
+	}
+	d.Set("suppression", suppression)
+
+	return nil
+}
+
+func resourceSettingIPSRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+
+	resp, err := c.c.GetSettingIps(ctx, site)
+	if _, ok := err.(*unifi.NotFoundError); ok {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceSettingIPSSetResourceData(resp, d, site)
+}
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var settingIPSLock = sync.Mutex{}
+
+func TestAccSettingIPS_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix("tfacc")
+	subnet, vlan := getTestVLAN(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			preCheck(t)
+			settingIPSLock.Lock()
+			t.Cleanup(func() {
+				settingIPSLock.Unlock()
+			})
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSettingIPSConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_setting_ips.test", "ips_mode", "ids"),
+					resource.TestCheckResourceAttr("unifi_setting_ips.test", "enabled_categories.#", "2"),
+					resource.TestCheckResourceAttr("unifi_setting_ips.test", "restrict_tor", "true"),
+					resource.TestCheckResourceAttr("unifi_setting_ips.test", "dns_filter.#", "0"),
+				),
+			},
+			importStep("unifi_setting_ips.test"),
+			{
+				Config: testAccSettingIPSConfig_blocks(name, subnet, vlan),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("unifi_setting_ips.test", "dns_filter.0.network_id", "unifi_network.test", "id"),
+					resource.TestCheckResourceAttr("unifi_setting_ips.test", "dns_filter.0.filter", "family"),
+					resource.TestCheckResourceAttr("unifi_setting_ips.test", "dns_filter.0.blocked_sites.#", "1"),
+					resource.TestCheckResourceAttr("unifi_setting_ips.test", "ad_blocking.0.network_ids.#", "1"),
+					resource.TestCheckResourceAttr("unifi_setting_ips.test", "honeypot.#", "1"),
+					resource.TestCheckResourceAttr("unifi_setting_ips.test", "suppression.0.whitelist.0.mode", "network"),
+				),
+			},
+			importStep("unifi_setting_ips.test"),
+			{
+				Config: testAccSettingIPSConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_setting_ips.test", "ad_blocking.#", "0"),
+					resource.TestCheckResourceAttr("unifi_setting_ips.test", "dns_filter.#", "0"),
+					resource.TestCheckResourceAttr("unifi_setting_ips.test", "honeypot.#", "0"),
+					resource.TestCheckResourceAttr("unifi_setting_ips.test", "suppression.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSettingIPSConfig_basic() string {
+	return `
+resource "unifi_setting_ips" "test" {
+	ips_mode           = "ids"
+	enabled_categories = ["emerging-malware", "botcc"]
+	restrict_tor       = true
+}
+`
+}
+
+func testAccSettingIPSConfig_blocks(name string, subnet *net.IPNet, vlan int) string {
+	return fmt.Sprintf(`
+resource "unifi_network" "test" {
+	name    = "%[1]s"
+	purpose = "corporate"
+	subnet  = "%[2]s"
+	vlan_id = %[3]d
+}
+
+resource "unifi_setting_ips" "test" {
+	ips_mode           = "ids"
+	enabled_categories = ["emerging-malware", "botcc"]
+	restrict_tor       = true
+
+	ad_blocking {
+		network_ids = [unifi_network.test.id]
+	}
+
+	dns_filter {
+		network_id    = unifi_network.test.id
+		filter        = "family"
+		blocked_sites = ["example.com"]
+	}
+
+	honeypot {
+		network_id = unifi_network.test.id
+		ip_address = cidrhost("%[2]s", 2)
+	}
+
+	suppression {
+		whitelist {
+			mode  = "network"
+			value = unifi_network.test.id
+		}
+	}
+}
+`, name, subnet, vlan)
+}
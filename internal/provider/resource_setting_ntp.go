@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/paultyng/go-unifi/unifi"
+)
+
+func resourceSettingNtp() *schema.Resource {
+	return &schema.Resource{
+		Description: "`unifi_setting_ntp` manages the NTP servers used by devices of a unifi site.",
+
+		CreateContext: resourceSettingNtpUpsert,
+		ReadContext:   resourceSettingNtpRead,
+		UpdateContext: resourceSettingNtpUpsert,
+		DeleteContext: schema.NoopContext,
+		Importer: &schema.ResourceImporter{
+			StateContext: importSiteAndID,
+		},
+		CustomizeDiff: resourceSettingNtpCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of the settings.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"site": {
+				Description: "The name of the site to associate the settings with.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"ntp_servers": {
+				Description: "The NTP servers, in order of preference. Supplying servers switches `setting_preference` to `manual`.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    4,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					// this doesn't let blank through
+					ValidateFunc: validation.StringLenBetween(1, 255),
+				},
+			},
+			"setting_preference": {
+				Description: "Whether the NTP servers are chosen automatically or set manually, valid values are `auto` and `manual`. " +
+					"When not set, it follows `ntp_servers`: `manual` with servers and `auto` without.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"auto", "manual"}, false),
+			},
+		},
+	}
+}
+
+func resourceSettingNtpUpdateResourceData(d *schema.ResourceData, setting *unifi.SettingNtp) error {
+	ntpServers, err := listToStringSlice(d.Get("ntp_servers").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("unable to convert ntp_servers to string slice: %w", err)
+	}
+	setting.NtpServer1 = append(ntpServers, "")[0]
+	setting.NtpServer2 = append(ntpServers, "", "")[1]
+	setting.NtpServer3 = append(ntpServers, "", "", "")[2]
+	setting.NtpServer4 = append(ntpServers, "", "", "", "")[3]
+
+	// the plan already follows ntp_servers when setting_preference is not configured
+	setting.SettingPreference = d.Get("setting_preference").(string)
+	if setting.SettingPreference == "" {
+		setting.SettingPreference = "auto"
+	}
+
+	return nil
+}
+
+func resourceSettingNtpCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("ntp_servers") {
+		return nil
+	}
+	hasServers := len(diff.Get("ntp_servers").([]interface{})) > 0
+
+	// setting_preference is computed, so only a configured auto conflicts with ntp_servers
+	configuredPreference := diff.GetRawConfig().GetAttr("setting_preference")
+	if !configuredPreference.IsNull() {
+		if hasServers && configuredPreference.IsKnown() && configuredPreference.AsString() == "auto" {
+			return fmt.Errorf("setting_preference cannot be auto when ntp_servers are set")
+		}
+		return nil
+	}
+
+	preference := "auto"
+	if hasServers {
+		preference = "manual"
+	}
+	if diff.Get("setting_preference").(string) != preference {
+		return diff.SetNew("setting_preference", preference)
+	}
+
+	return nil
+}
+
+func resourceSettingNtpUpsert(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+
+	req, err := c.c.GetSettingNtp(ctx, site)
+	if _, ok := err.(*unifi.NotFoundError); ok {
+		// the setting is only persisted once it has been saved for the site
+		req, err = &unifi.SettingNtp{}, nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = resourceSettingNtpUpdateResourceData(d, req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := c.c.UpdateSettingNtp(ctx, site, req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resp.ID)
+	return resourceSettingNtpSetResourceData(resp, d, site)
+}
+
+func resourceSettingNtpSetResourceData(resp *unifi.SettingNtp, d *schema.ResourceData, site string) diag.Diagnostics {
+	d.Set("site", site)
+	d.Set("setting_preference", resp.SettingPreference)
+
+	ntpServers := []string{}
+	for _, s := range []string{
+		resp.NtpServer1,
+		resp.NtpServer2,
+		resp.NtpServer3,
+		resp.NtpServer4,
+	} {
+		if s == "" {
+			continue
+		}
+		ntpServers = append(ntpServers, s)
+	}
+	d.Set("ntp_servers", ntpServers)
+
+	return nil
+}
+
+func resourceSettingNtpRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+
+	resp, err := c.c.GetSettingNtp(ctx, site)
+	if _, ok := err.(*unifi.NotFoundError); ok {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceSettingNtpSetResourceData(resp, d, site)
+}
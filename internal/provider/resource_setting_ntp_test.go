@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var settingNtpLock = sync.Mutex{}
+
+func TestAccSettingNtp_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			preCheck(t)
+			settingNtpLock.Lock()
+			t.Cleanup(func() {
+				settingNtpLock.Unlock()
+			})
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSettingNtpConfig_servers(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_setting_ntp.test", "setting_preference", "manual"),
+					resource.TestCheckResourceAttr("unifi_setting_ntp.test", "ntp_servers.#", "2"),
+					resource.TestCheckResourceAttr("unifi_setting_ntp.test", "ntp_servers.0", "10.1.2.3"),
+					resource.TestCheckResourceAttr("unifi_setting_ntp.test", "ntp_servers.1", "time.example.com"),
+				),
+			},
+			importStep("unifi_setting_ntp.test"),
+			{
+				Config: testAccSettingNtpConfig_auto(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_setting_ntp.test", "setting_preference", "auto"),
+					resource.TestCheckResourceAttr("unifi_setting_ntp.test", "ntp_servers.#", "0"),
+				),
+			},
+			importStep("unifi_setting_ntp.test"),
+			{
+				// the stored auto preference switches to manual when servers are added
+				Config: testAccSettingNtpConfig_servers(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_setting_ntp.test", "setting_preference", "manual"),
+					resource.TestCheckResourceAttr("unifi_setting_ntp.test", "ntp_servers.#", "2"),
+				),
+			},
+			{
+				// and back to auto when they are removed
+				Config: testAccSettingNtpConfig_empty(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_setting_ntp.test", "setting_preference", "auto"),
+					resource.TestCheckResourceAttr("unifi_setting_ntp.test", "ntp_servers.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSettingNtp_site(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			preCheck(t)
+			settingNtpLock.Lock()
+			t.Cleanup(func() {
+				settingNtpLock.Unlock()
+			})
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSettingNtpConfig_site(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("unifi_setting_ntp.test", "site", "unifi_site.test", "name"),
+					resource.TestCheckResourceAttr("unifi_setting_ntp.test", "setting_preference", "manual"),
+					resource.TestCheckResourceAttr("unifi_setting_ntp.test", "ntp_servers.#", "1"),
+					resource.TestCheckResourceAttr("unifi_setting_ntp.test", "ntp_servers.0", "10.1.2.3"),
+				),
+			},
+			{
+				ResourceName:      "unifi_setting_ntp.test",
+				ImportState:       true,
+				ImportStateIdFunc: siteAndIDImportStateIDFunc("unifi_setting_ntp.test"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSettingNtp_autoWithServers(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			preCheck(t)
+			settingNtpLock.Lock()
+			t.Cleanup(func() {
+				settingNtpLock.Unlock()
+			})
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSettingNtpConfig_autoWithServers(),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("setting_preference cannot be auto"),
+			},
+		},
+	})
+}
+
+func testAccSettingNtpConfig_servers() string {
+	return `
+resource "unifi_setting_ntp" "test" {
+	ntp_servers = [
+		"10.1.2.3",
+		"time.example.com",
+	]
+}
+`
+}
+
+func testAccSettingNtpConfig_auto() string {
+	return `
+resource "unifi_setting_ntp" "test" {
+	setting_preference = "auto"
+}
+`
+}
+
+func testAccSettingNtpConfig_empty() string {
+	return `
+resource "unifi_setting_ntp" "test" {
+}
+`
+}
+
+func testAccSettingNtpConfig_site() string {
+	return `
+resource "unifi_site" "test" {
+	description = "test"
+}
+
+resource "unifi_setting_ntp" "test" {
+	site        = unifi_site.test.name
+	ntp_servers = ["10.1.2.3"]
+}
+`
+}
+
+func testAccSettingNtpConfig_autoWithServers() string {
+	return `
+resource "unifi_setting_ntp" "test" {
+	setting_preference = "auto"
+	ntp_servers        = ["10.1.2.3"]
+}
+`
+}
@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/paultyng/go-unifi/unifi"
+)
+
+func resourceSettingRsyslogd() *schema.Resource {
+	return &schema.Resource{
+		Description: "`unifi_setting_rsyslogd` manages the remote syslog and netconsole settings for a unifi site.",
+
+		CreateContext: resourceSettingRsyslogdUpsert,
+		ReadContext:   resourceSettingRsyslogdRead,
+		UpdateContext: resourceSettingRsyslogdUpsert,
+		DeleteContext: schema.NoopContext,
+		Importer: &schema.ResourceImporter{
+			StateContext: importSiteAndID,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of the settings.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"site": {
+				Description: "The name of the site to associate the settings with.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"enabled": {
+				Description: "Whether remote syslog is enabled.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"ip": {
+				Description:  "The IP address of the remote syslog server.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"port": {
+				Description:  "The port of the remote syslog server.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"debug": {
+				Description: "Whether debug level logs are sent to the remote syslog server.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"netconsole_enabled": {
+				Description: "Whether netconsole logging is enabled.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"netconsole_host": {
+				Description: "The host receiving netconsole logs.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"netconsole_port": {
+				Description:  "The port receiving netconsole logs.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"this_controller": {
+				Description: "Whether device logs are also sent to this controller.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"this_controller_encrypted_only": {
+				Description: "Whether only encrypted logs are accepted by this controller. Requires controller version 7 or later.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceSettingRsyslogdUpdateResourceData(d *schema.ResourceData, meta interface{}, setting *unifi.SettingRsyslogd) error {
+	c := meta.(*client)
+
+	// only check the raw config, a computed value in state should not trip the version check
+	if encryptedOnly := d.GetRawConfig().GetAttr("this_controller_encrypted_only"); !encryptedOnly.IsNull() {
+		if v := c.ControllerVersion(); v.LessThan(controllerV7) {
+			return fmt.Errorf("this_controller_encrypted_only is not supported on controller version %v", c.ControllerVersion())
+		}
+
+		setting.ThisControllerEncryptedOnly = encryptedOnly.True()
+	}
+
+	setting.Enabled = d.Get("enabled").(bool)
+	setting.IP = d.Get("ip").(string)
+	setting.Port = d.Get("port").(int)
+	setting.Debug = d.Get("debug").(bool)
+	setting.NetconsoleEnabled = d.Get("netconsole_enabled").(bool)
+	setting.NetconsoleHost = d.Get("netconsole_host").(string)
+	setting.NetconsolePort = d.Get("netconsole_port").(int)
+	setting.ThisController = d.Get("this_controller").(bool)
+
+	if setting.Enabled && setting.IP == "" {
+		return fmt.Errorf("ip is required when enabled is true")
+	}
+	if setting.NetconsoleEnabled && setting.NetconsoleHost == "" {
+		return fmt.Errorf("netconsole_host is required when netconsole_enabled is true")
+	}
+
+	return nil
+}
+
+func resourceSettingRsyslogdUpsert(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+
+	req, err := c.c.GetSettingRsyslogd(ctx, site)
+	if _, ok := err.(*unifi.NotFoundError); ok {
+		// the setting is only persisted once it has been saved for the site
+		req, err = &unifi.SettingRsyslogd{}, nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = resourceSettingRsyslogdUpdateResourceData(d, meta, req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := c.c.UpdateSettingRsyslogd(ctx, site, req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resp.ID)
+	return resourceSettingRsyslogdSetResourceData(resp, d, meta, site)
+}
+
+func resourceSettingRsyslogdSetResourceData(resp *unifi.SettingRsyslogd, d *schema.ResourceData, meta interface{}, site string) diag.Diagnostics {
+	d.Set("site", site)
+	d.Set("enabled", resp.Enabled)
+	d.Set("ip", resp.IP)
+	d.Set("debug", resp.Debug)
+	d.Set("netconsole_enabled", resp.NetconsoleEnabled)
+	d.Set("netconsole_host", resp.NetconsoleHost)
+	d.Set("this_controller", resp.ThisController)
+	d.Set("this_controller_encrypted_only", resp.ThisControllerEncryptedOnly)
+
+	// unset ports are returned as empty strings, which go-unifi reads as 0,
+	// so keep the previous value rather than storing an invalid port
+	if resp.Port != 0 {
+		d.Set("port", resp.Port)
+	}
+	if resp.NetconsolePort != 0 {
+		d.Set("netconsole_port", resp.NetconsolePort)
+	}
+
+	return nil
+}
+
+func resourceSettingRsyslogdRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+
+	resp, err := c.c.GetSettingRsyslogd(ctx, site)
+	if _, ok := err.(*unifi.NotFoundError); ok {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceSettingRsyslogdSetResourceData(resp, d, meta, site)
+}
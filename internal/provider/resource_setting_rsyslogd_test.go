@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var settingRsyslogdLock = sync.Mutex{}
+
+func TestAccSettingRsyslogd_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			preCheck(t)
+			settingRsyslogdLock.Lock()
+			t.Cleanup(func() {
+				settingRsyslogdLock.Unlock()
+			})
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSettingRsyslogdConfig_basic(514),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_setting_rsyslogd.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("unifi_setting_rsyslogd.test", "port", "514"),
+				),
+			},
+			importStep("unifi_setting_rsyslogd.test"),
+			{
+				Config: testAccSettingRsyslogdConfig_basic(5514),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_setting_rsyslogd.test", "port", "5514"),
+				),
+			},
+			importStep("unifi_setting_rsyslogd.test"),
+		},
+	})
+}
+
+func TestAccSettingRsyslogd_netconsole(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			preCheck(t)
+			settingRsyslogdLock.Lock()
+			t.Cleanup(func() {
+				settingRsyslogdLock.Unlock()
+			})
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSettingRsyslogdConfig_netconsole(),
+				Check:  resource.ComposeTestCheckFunc(),
+			},
+			importStep("unifi_setting_rsyslogd.test"),
+		},
+	})
+}
+
+func TestAccSettingRsyslogd_encryptedOnly_v6(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			preCheck(t)
+			preCheckVersionConstraint(t, "< 7")
+			settingRsyslogdLock.Lock()
+			t.Cleanup(func() {
+				settingRsyslogdLock.Unlock()
+			})
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSettingRsyslogdConfig_encryptedOnly(),
+				ExpectError: regexp.MustCompile("this_controller_encrypted_only is not supported"),
+			},
+		},
+	})
+}
+
+func TestAccSettingRsyslogd_encryptedOnly_v7(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			preCheck(t)
+			preCheckVersionConstraint(t, ">= 7")
+			settingRsyslogdLock.Lock()
+			t.Cleanup(func() {
+				settingRsyslogdLock.Unlock()
+			})
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSettingRsyslogdConfig_encryptedOnly(),
+				Check:  resource.ComposeTestCheckFunc(),
+			},
+			importStep("unifi_setting_rsyslogd.test"),
+		},
+	})
+}
+
+func testAccSettingRsyslogdConfig_basic(port int) string {
+	return fmt.Sprintf(`
+resource "unifi_setting_rsyslogd" "test" {
+	enabled = true
+	ip      = "10.1.2.3"
+	port    = %d
+}
+`, port)
+}
+
+func testAccSettingRsyslogdConfig_netconsole() string {
+	return `
+resource "unifi_setting_rsyslogd" "test" {
+	netconsole_enabled = true
+	netconsole_host    = "10.1.2.4"
+	netconsole_port    = 6666
+}
+`
+}
+
+func testAccSettingRsyslogdConfig_encryptedOnly() string {
+	return `
+resource "unifi_setting_rsyslogd" "test" {
+	this_controller                = true
+	this_controller_encrypted_only = true
+}
+`
+}
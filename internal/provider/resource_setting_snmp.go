@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/paultyng/go-unifi/unifi"
+)
+
+var (
+	snmpCommunityRegexp   = regexp.MustCompile(`^.{1,256}$`)
+	validateSnmpCommunity = validation.StringMatch(snmpCommunityRegexp, "invalid SNMP community")
+
+	snmpUsernameRegexp   = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,30}$`)
+	validateSnmpUsername = validation.StringMatch(snmpUsernameRegexp, "invalid SNMPv3 username")
+
+	snmpPasswordRegexp   = regexp.MustCompile(`^[^'"]{8,32}$`)
+	validateSnmpPassword = validation.StringMatch(snmpPasswordRegexp, "invalid SNMPv3 password")
+)
+
+func resourceSettingSnmp() *schema.Resource {
+	return &schema.Resource{
+		Description: "`unifi_setting_snmp` manages the SNMP settings for a unifi site.",
+
+		CreateContext: resourceSettingSnmpCreate,
+		ReadContext:   resourceSettingSnmpRead,
+		UpdateContext: resourceSettingSnmpUpdate,
+		DeleteContext: schema.NoopContext,
+		Importer: &schema.ResourceImporter{
+			StateContext: importSiteAndID,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of the settings.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"site": {
+				Description: "The name of the site to associate the settings with.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"enabled": {
+				Description: "Enable SNMPv1 and SNMPv2c.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"community": {
+				Description:  "The SNMPv1 and SNMPv2c community string.",
+				Type:         schema.TypeString,
+				Sensitive:    true,
+				Optional:     true,
+				ValidateFunc: validateSnmpCommunity,
+			},
+			"v3_enabled": {
+				Description: "Enable SNMPv3.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"username": {
+				Description:  "The SNMPv3 username.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateSnmpUsername,
+			},
+			"password": {
+				Description:  "The SNMPv3 password, must be between 8 and 32 characters and cannot contain quotes.",
+				Type:         schema.TypeString,
+				Sensitive:    true,
+				Optional:     true,
+				ValidateFunc: validateSnmpPassword,
+			},
+		},
+	}
+}
+
+func resourceSettingSnmpGetResourceData(d *schema.ResourceData, meta interface{}) (*unifi.SettingSnmp, error) {
+	enabled := d.Get("enabled").(bool)
+	community := d.Get("community").(string)
+	if enabled && community == "" {
+		return nil, fmt.Errorf("community is required when enabled is true")
+	}
+
+	v3Enabled := d.Get("v3_enabled").(bool)
+	username := d.Get("username").(string)
+	password := d.Get("password").(string)
+	if v3Enabled && (username == "" || password == "") {
+		return nil, fmt.Errorf("username and password are required when v3_enabled is true")
+	}
+
+	return &unifi.SettingSnmp{
+		Enabled:   enabled,
+		Community: community,
+		EnabledV3: v3Enabled,
+		Username:  username,
+		XPassword: password,
+	}, nil
+}
+
+func resourceSettingSnmpCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	req, err := resourceSettingSnmpGetResourceData(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+
+	resp, err := c.c.UpdateSettingSnmp(ctx, site, req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resp.ID)
+
+	return resourceSettingSnmpSetResourceData(resp, d, meta, site)
+}
+
+func resourceSettingSnmpSetResourceData(resp *unifi.SettingSnmp, d *schema.ResourceData, meta interface{}, site string) diag.Diagnostics {
+	d.Set("site", site)
+	d.Set("enabled", resp.Enabled)
+	d.Set("community", resp.Community)
+	d.Set("v3_enabled", resp.EnabledV3)
+	d.Set("username", resp.Username)
+	d.Set("password", resp.XPassword)
+	return nil
+}
+
+func resourceSettingSnmpRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+
+	resp, err := c.c.GetSettingSnmp(ctx, site)
+	if _, ok := err.(*unifi.NotFoundError); ok {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceSettingSnmpSetResourceData(resp, d, meta, site)
+}
+
+func resourceSettingSnmpUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	req, err := resourceSettingSnmpGetResourceData(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req.ID = d.Id()
+	site := d.Get("site").(string)
+	if site == "" {
+		site = c.site
+	}
+
+	resp, err := c.c.UpdateSettingSnmp(ctx, site, req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceSettingSnmpSetResourceData(resp, d, meta, site)
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var settingSnmpLock = sync.Mutex{}
+
+func TestAccSettingSnmp_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			preCheck(t)
+			settingSnmpLock.Lock()
+			t.Cleanup(func() {
+				settingSnmpLock.Unlock()
+			})
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSettingSnmpConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_setting_snmp.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("unifi_setting_snmp.test", "community", "public"),
+				),
+			},
+			importStep("unifi_setting_snmp.test"),
+		},
+	})
+}
+
+func TestAccSettingSnmp_v3(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			preCheck(t)
+			settingSnmpLock.Lock()
+			t.Cleanup(func() {
+				settingSnmpLock.Unlock()
+			})
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSettingSnmpConfig_v3(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_setting_snmp.test", "v3_enabled", "true"),
+					resource.TestCheckResourceAttr("unifi_setting_snmp.test", "username", "monitor"),
+				),
+			},
+			{
+				ResourceName:      "unifi_setting_snmp.test",
+				ImportState:       true,
+				ImportStateIdFunc: siteAndIDImportStateIDFunc("unifi_setting_snmp.test"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSettingSnmp_v3MissingPassword(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			preCheck(t)
+			settingSnmpLock.Lock()
+			t.Cleanup(func() {
+				settingSnmpLock.Unlock()
+			})
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSettingSnmpConfig_v3MissingPassword(),
+				ExpectError: regexp.MustCompile("username and password are required"),
+			},
+		},
+	})
+}
+
+func testAccSettingSnmpConfig_basic() string {
+	return `
+resource "unifi_setting_snmp" "test" {
+	enabled   = true
+	community = "public"
+}
+`
+}
+
+func testAccSettingSnmpConfig_v3() string {
+	return `
+resource "unifi_site" "test" {
+	description = "test"
+}
+
+resource "unifi_setting_snmp" "test" {
+	site       = unifi_site.test.name
+	v3_enabled = true
+	username   = "monitor"
+	password   = "s3cr3tpassw0rd"
+}
+`
+}
+
+func testAccSettingSnmpConfig_v3MissingPassword() string {
+	return `
+resource "unifi_setting_snmp" "test" {
+	v3_enabled = true
+	username   = "monitor"
+}
+`
+}
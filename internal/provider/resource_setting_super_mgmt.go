@@ -0,0 +1,338 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/paultyng/go-unifi/unifi"
+)
+
+// superSite is the site controller wide settings are stored in.
+const superSite = "super"
+
+// settingSuperMgmtDataRetentionFields maps the data retention arguments to their scale in the API.
+var settingSuperMgmtDataRetentionFields = map[string]string{
+	"data_retention_5minutes_hours": "5 minute",
+	"data_retention_hourly_hours":   "hourly",
+	"data_retention_daily_hours":    "daily",
+	"data_retention_monthly_hours":  "monthly",
+	"data_retention_others_hours":   "other",
+}
+
+// settingSuperMgmtSecretFields maps the secret arguments to their JSON names.
+var settingSuperMgmtSecretFields = map[string]string{
+	"autobackup_s3_access_secret": "autobackup_s3_access_secret",
+	"ssh_password":                "x_ssh_password",
+}
+
+func resourceSettingSuperMgmt() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"id": {
+			Description: "The ID of the settings.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"auto_upgrade": {
+			Description: "Whether devices are automatically upgraded to the latest firmware.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"autobackup_enabled": {
+			Description: "Whether the controller is backed up automatically.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"autobackup_cron_expr": {
+			Description:  "The cron expression of the automatic backups, for example `0 1 * * 1` for every Monday at 01:00.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: cronValidate,
+		},
+		"autobackup_timezone": {
+			Description: "The time zone `autobackup_cron_expr` is evaluated in, for example `Europe/Amsterdam`.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"autobackup_days": {
+			Description:  "The number of days of data included in automatic backups, `0` includes only the settings.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		"autobackup_max_files": {
+			Description:  "The number of automatic backups to retain.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"autobackup_post_actions": {
+			Description: "Where automatic backups are copied to, valid values are `copy_local`, `copy_s3`, `copy_gcs` and `copy_cloud`.",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"copy_local", "copy_s3", "copy_gcs", "copy_cloud"}, false),
+			},
+		},
+		"autobackup_local_path": {
+			Description: "The directory automatic backups are copied to for `copy_local`.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"autobackup_s3_bucket": {
+			Description: "The S3 bucket automatic backups are copied to for `copy_s3`.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"autobackup_s3_access_key": {
+			Description: "The access key used to copy automatic backups to S3.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"autobackup_s3_access_secret": {
+			Description: "The secret of `autobackup_s3_access_key`.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+		},
+		"autobackup_gcs_bucket": {
+			Description: "The Google Cloud Storage bucket automatic backups are copied to for `copy_gcs`.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"autobackup_gcs_certificate_path": {
+			Description: "The path on the controller of the service account certificate used to copy automatic backups to Google Cloud Storage.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"backup_to_cloud_enabled": {
+			Description: "Whether backups are uploaded to the UI cloud.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"data_retention_setting_preference": {
+			Description:  "Whether statistics are retained for the default periods (`auto`) or the `data_retention_*_hours` periods (`manual`).",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice([]string{"auto", "manual"}, false),
+		},
+		"override_inform_host": {
+			Description: "Whether devices are told to inform `override_inform_host_location` instead of the address they adopted through.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"override_inform_host_location": {
+			Description: "The hostname or IP address devices inform when `override_inform_host` is `true`.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"led_enabled": {
+			Description: "Whether the status LEDs of devices are on by default.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"ssh_username": {
+			Description: "The SSH username of adopted devices.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"ssh_password": {
+			Description: "The SSH password of adopted devices.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+		},
+	}
+
+	for k, scale := range settingSuperMgmtDataRetentionFields {
+		s[k] = &schema.Schema{
+			Description:  fmt.Sprintf("The number of hours %s statistics are retained for when `data_retention_setting_preference` is `manual`.", scale),
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		}
+	}
+
+	return &schema.Resource{
+		Description: "`unifi_setting_super_mgmt` manages the controller wide management settings, such as automatic backups, " +
+			"data retention, the inform host and device SSH credentials. These settings always act on the super site, " +
+			"the `site` of the provider is not used.",
+
+		CreateContext: resourceSettingSuperMgmtUpsert,
+		ReadContext:   resourceSettingSuperMgmtRead,
+		UpdateContext: resourceSettingSuperMgmtUpsert,
+		DeleteContext: schema.NoopContext,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: s,
+	}
+}
+
+// settingSuperMgmtDataRetentionField returns the data retention hours of an argument.
+func settingSuperMgmtDataRetentionField(setting *unifi.SettingSuperMgmt, k string) *int {
+	switch k {
+	case "data_retention_5minutes_hours":
+		return &setting.DataRetentionTimeInHoursFor5MinutesScale
+	case "data_retention_hourly_hours":
+		return &setting.DataRetentionTimeInHoursForHourlyScale
+	case "data_retention_daily_hours":
+		return &setting.DataRetentionTimeInHoursForDailyScale
+	case "data_retention_monthly_hours":
+		return &setting.DataRetentionTimeInHoursForMonthlyScale
+	case "data_retention_others_hours":
+		return &setting.DataRetentionTimeInHoursForOthers
+	}
+	panic("unexpected data retention field " + k)
+}
+
+func resourceSettingSuperMgmtUpdateResourceData(d *schema.ResourceData, setting *unifi.SettingSuperMgmt) error {
+	postActions, err := setToStringSlice(d.Get("autobackup_post_actions").(*schema.Set))
+	if err != nil {
+		return fmt.Errorf("unable to convert autobackup_post_actions to string slice: %w", err)
+	}
+
+	setting.AutoUpgrade = d.Get("auto_upgrade").(bool)
+	setting.AutobackupEnabled = d.Get("autobackup_enabled").(bool)
+	setting.AutobackupCronExpr = d.Get("autobackup_cron_expr").(string)
+	setting.AutobackupTimezone = d.Get("autobackup_timezone").(string)
+	setting.AutobackupDays = d.Get("autobackup_days").(int)
+	setting.AutobackupMaxFiles = d.Get("autobackup_max_files").(int)
+	setting.AutobackupPostActions = postActions
+	setting.AutobackupLocalPath = d.Get("autobackup_local_path").(string)
+	setting.AutobackupS3Bucket = d.Get("autobackup_s3_bucket").(string)
+	setting.AutobackupS3AccessKey = d.Get("autobackup_s3_access_key").(string)
+	setting.AutobackupGcsBucket = d.Get("autobackup_gcs_bucket").(string)
+	setting.AutobackupGcsCertificatePath = d.Get("autobackup_gcs_certificate_path").(string)
+	setting.BackupToCloudEnabled = d.Get("backup_to_cloud_enabled").(bool)
+	setting.DataRetentionSettingPreference = d.Get("data_retention_setting_preference").(string)
+	setting.OverrideInformHost = d.Get("override_inform_host").(bool)
+	setting.OverrideInformHostLocation = d.Get("override_inform_host_location").(string)
+	setting.LedEnabled = d.Get("led_enabled").(bool)
+	setting.XSshUsername = d.Get("ssh_username").(string)
+
+	// the secrets are not always returned by the controller, so only send them when configured
+	if v, ok := d.GetOk("autobackup_s3_access_secret"); ok {
+		setting.AutobackupS3AccessSecret = v.(string)
+	}
+	if v, ok := d.GetOk("ssh_password"); ok {
+		setting.XSshPassword = v.(string)
+	}
+
+	for k := range settingSuperMgmtDataRetentionFields {
+		*settingSuperMgmtDataRetentionField(setting, k) = d.Get(k).(int)
+
+		if !d.GetRawConfig().GetAttr(k).IsNull() && setting.DataRetentionSettingPreference != "manual" {
+			return fmt.Errorf("%s can only be set when data_retention_setting_preference is manual", k)
+		}
+	}
+
+	if setting.OverrideInformHost && setting.OverrideInformHostLocation == "" {
+		return fmt.Errorf("override_inform_host_location is required when override_inform_host is true")
+	}
+
+	return nil
+}
+
+func resourceSettingSuperMgmtUpsert(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	req, err := c.c.GetSettingSuperMgmt(ctx, superSite)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = resourceSettingSuperMgmtUpdateResourceData(d, req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the secrets are omitted when empty, so removing them from the config has to clear them explicitly
+	fields := map[string]interface{}{}
+	for k, jsonName := range settingSuperMgmtSecretFields {
+		if d.HasChange(k) && d.Get(k).(string) == "" {
+			fields[jsonName] = ""
+		}
+	}
+
+	resp, err := c.c.UpdateSettingSuperMgmtFields(ctx, superSite, req, fields)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resp.ID)
+	return resourceSettingSuperMgmtSetResourceData(resp, d)
+}
+
+func resourceSettingSuperMgmtSetResourceData(resp *unifi.SettingSuperMgmt, d *schema.ResourceData) diag.Diagnostics {
+	d.Set("auto_upgrade", resp.AutoUpgrade)
+	d.Set("autobackup_enabled", resp.AutobackupEnabled)
+	d.Set("autobackup_cron_expr", resp.AutobackupCronExpr)
+	d.Set("autobackup_timezone", resp.AutobackupTimezone)
+	d.Set("autobackup_days", resp.AutobackupDays)
+	d.Set("autobackup_max_files", resp.AutobackupMaxFiles)
+	d.Set("autobackup_post_actions", stringSliceToSet(resp.AutobackupPostActions))
+	d.Set("autobackup_local_path", resp.AutobackupLocalPath)
+	d.Set("autobackup_s3_bucket", resp.AutobackupS3Bucket)
+	d.Set("autobackup_s3_access_key", resp.AutobackupS3AccessKey)
+	d.Set("autobackup_gcs_bucket", resp.AutobackupGcsBucket)
+	d.Set("autobackup_gcs_certificate_path", resp.AutobackupGcsCertificatePath)
+	d.Set("backup_to_cloud_enabled", resp.BackupToCloudEnabled)
+	d.Set("data_retention_setting_preference", resp.DataRetentionSettingPreference)
+	d.Set("override_inform_host", resp.OverrideInformHost)
+	d.Set("override_inform_host_location", resp.OverrideInformHostLocation)
+	d.Set("led_enabled", resp.LedEnabled)
+	d.Set("ssh_username", resp.XSshUsername)
+
+	// the secrets are not always returned, keep the configured ones unless they were cleared
+	if resp.AutobackupS3AccessSecret != "" || d.Get("autobackup_s3_access_secret").(string) == "" {
+		d.Set("autobackup_s3_access_secret", resp.AutobackupS3AccessSecret)
+	}
+	if resp.XSshPassword != "" || d.Get("ssh_password").(string) == "" {
+		d.Set("ssh_password", resp.XSshPassword)
+	}
+
+	for k := range settingSuperMgmtDataRetentionFields {
+		d.Set(k, *settingSuperMgmtDataRetentionField(resp, k))
+	}
+
+	return nil
+}
+
+func resourceSettingSuperMgmtRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client)
+
+	resp, err := c.c.GetSettingSuperMgmt(ctx, superSite)
+	if _, ok := err.(*unifi.NotFoundError); ok {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceSettingSuperMgmtSetResourceData(resp, d)
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var settingSuperMgmtLock = sync.Mutex{}
+
+func TestAccSettingSuperMgmt_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			preCheck(t)
+			settingSuperMgmtLock.Lock()
+			t.Cleanup(func() {
+				settingSuperMgmtLock.Unlock()
+			})
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSettingSuperMgmtConfig_autobackup("0 2 * * *", 7),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_setting_super_mgmt.test", "autobackup_enabled", "true"),
+					resource.TestCheckResourceAttr("unifi_setting_super_mgmt.test", "autobackup_cron_expr", "0 2 * * *"),
+					resource.TestCheckResourceAttr("unifi_setting_super_mgmt.test", "autobackup_max_files", "7"),
+				),
+			},
+			importStep("unifi_setting_super_mgmt.test", "autobackup_s3_access_secret", "ssh_password"),
+			{
+				Config: testAccSettingSuperMgmtConfig_autobackup("30 3 * * 1", 4),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_setting_super_mgmt.test", "autobackup_cron_expr", "30 3 * * 1"),
+					resource.TestCheckResourceAttr("unifi_setting_super_mgmt.test", "autobackup_max_files", "4"),
+				),
+			},
+			importStep("unifi_setting_super_mgmt.test", "autobackup_s3_access_secret", "ssh_password"),
+		},
+	})
+}
+
+func TestAccSettingSuperMgmt_dataRetention(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			preCheck(t)
+			settingSuperMgmtLock.Lock()
+			t.Cleanup(func() {
+				settingSuperMgmtLock.Unlock()
+			})
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSettingSuperMgmtConfig_dataRetention("manual", 168),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_setting_super_mgmt.test", "data_retention_setting_preference", "manual"),
+					resource.TestCheckResourceAttr("unifi_setting_super_mgmt.test", "data_retention_hourly_hours", "168"),
+				),
+			},
+			importStep("unifi_setting_super_mgmt.test", "autobackup_s3_access_secret", "ssh_password"),
+			{
+				Config:      testAccSettingSuperMgmtConfig_dataRetention("auto", 168),
+				ExpectError: regexp.MustCompile("data_retention_hourly_hours can only be set when data_retention_setting_preference is manual"),
+			},
+		},
+	})
+}
+
+func TestAccSettingSuperMgmt_sshPassword(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			preCheck(t)
+			settingSuperMgmtLock.Lock()
+			t.Cleanup(func() {
+				settingSuperMgmtLock.Unlock()
+			})
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSettingSuperMgmtConfig_sshPassword("hunter22"),
+				Check:  resource.TestCheckResourceAttr("unifi_setting_super_mgmt.test", "ssh_password", "hunter22"),
+			},
+			{
+				Config: testAccSettingSuperMgmtConfig_sshUsername,
+				Check:  resource.TestCheckResourceAttr("unifi_setting_super_mgmt.test", "ssh_password", ""),
+			},
+			{
+				Config:   testAccSettingSuperMgmtConfig_sshUsername,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccSettingSuperMgmtConfig_autobackup(cron string, maxFiles int) string {
+	return fmt.Sprintf(`
+resource "unifi_setting_super_mgmt" "test" {
+	autobackup_enabled   = true
+	autobackup_cron_expr = %q
+	autobackup_max_files = %d
+}
+`, cron, maxFiles)
+}
+
+func testAccSettingSuperMgmtConfig_dataRetention(preference string, hourly int) string {
+	return fmt.Sprintf(`
+resource "unifi_setting_super_mgmt" "test" {
+	data_retention_setting_preference = %q
+	data_retention_hourly_hours       = %d
+}
+`, preference, hourly)
+}
+
+func testAccSettingSuperMgmtConfig_sshPassword(password string) string {
+	return fmt.Sprintf(`
+resource "unifi_setting_super_mgmt" "test" {
+	ssh_username = "admin"
+	ssh_password = %q
+}
+`, password)
+}
+
+const testAccSettingSuperMgmtConfig_sshUsername = `
+resource "unifi_setting_super_mgmt" "test" {
+	ssh_username = "admin"
+}
+`
@@ -13,6 +13,9 @@
 var (
 	wlanValidMinimumDataRate2g = []int{1000, 2000, 5500, 6000, 9000, 11000, 12000, 18000, 24000, 36000, 48000, 54000}
 	wlanValidMinimumDataRate5g = []int{6000, 9000, 12000, 18000, 24000, 36000, 48000, 54000}
+
+	wlanValidSAEGroups            = []int{19, 20, 21}
+	wlanValidRADIUSMACAuthFormats = []string{"none_lower", "hyphen_lower", "colon_lower", "none_upper", "hyphen_upper", "colon_upper"}
 )
 
 func resourceWLAN() *schema.Resource {
@@ -23,6 +26,7 @@ func resourceWLAN() *schema.Resource {
 		ReadContext:   resourceWLANRead,
 		UpdateContext: resourceWLANUpdate,
 		DeleteContext: resourceWLANDelete,
+		CustomizeDiff: resourceWLANCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: importSiteAndID,
 		},
@@ -66,6 +70,33 @@ func resourceWLAN() *schema.Resource {
 				Type:        schema.TypeBool,
 				Optional:    true,
 			},
+			"wpa3_fast_roaming": {
+				Description: "Enable fast roaming for WPA 3 (requires `wpa3_support` and `fast_roaming_enabled`). Requires controller version 7.0 or higher.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"wpa3_enhanced_192": {
+				Description: "Enable WPA 3 Enterprise 192-bit mode (security must be `wpaeap` and `pmf_mode` must be `required`). Requires controller version 7.0 or higher.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"sae_groups": {
+				Description: "The elliptic curve groups offered for WPA 3 SAE authentication, valid values are " + markdownValueListInt(wlanValidSAEGroups) + ". Requires `wpa3_support` and controller version 7.0 or higher.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntInSlice(wlanValidSAEGroups),
+				},
+			},
+			"sae_anti_clogging": {
+				Description:  "The number of open WPA 3 SAE sessions after which anti-clogging tokens are required. Requires `wpa3_support` and controller version 7.0 or higher.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			"pmf_mode": {
 				Description:  "Enable Protected Management Frames. This cannot be disabled if using WPA 3. Valid values are `required`, `optional` and `disabled`.",
 				Type:         schema.TypeString,
@@ -80,6 +111,29 @@ func resourceWLAN() *schema.Resource {
 				Optional:  true,
 				Sensitive: true,
 			},
+			"private_preshared_key": {
+				Description: "Additional passphrases for the network, each placing its clients in a different network. " +
+					"Only valid if `security` is `wpapsk` and not WPA 3 only. The controller maps each passphrase to the VLAN " +
+					"of its network, so the network must be the only LAN network with that VLAN.",
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"passphrase": {
+							Description:  "The passphrase of the key.",
+							Type:         schema.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringLenBetween(8, 255),
+						},
+						"network_id": {
+							Description: "ID of the network the clients using this passphrase are placed in.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+					},
+				},
+			},
 			"hide_ssid": {
 				Description: "Indicates whether or not to hide the SSID from broadcast.",
 				Type:        schema.TypeBool,
@@ -216,12 +270,91 @@ func resourceWLAN() *schema.Resource {
 				// TODO: this validation is from the UI, if other values work, perhaps remove this is set it to a range instead?
 				ValidateFunc: validation.IntInSlice(append([]int{0}, wlanValidMinimumDataRate5g...)),
 			},
+			"minimum_data_rate_2g_advertising_rates": {
+				Description: "Only advertise the data rates above `minimum_data_rate_2g_kbps` in 2G beacons.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"minimum_data_rate_5g_advertising_rates": {
+				Description: "Only advertise the data rates above `minimum_data_rate_5g_kbps` in 5G beacons.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
 			"wlan_band": {
-				Description:  "Radio band your WiFi network will use.",
+				Description:  "Radio band your WiFi network will use. Ignored when `wlan_bands` is set.",
 				Type:         schema.TypeString,
 				Optional:     true,
 				ValidateFunc: validation.StringInSlice([]string{"2g", "5g", "both"}, false),
 				Default:      "both",
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					config := d.GetRawConfig()
+					return !config.IsNull() && !config.GetAttr("wlan_bands").IsNull()
+				},
+			},
+			"wlan_bands": {
+				Description: "Radio bands your WiFi network will use, valid values are `2g`, `5g` and `6g`. " +
+					"This replaces `wlan_band` and is required to use 6 GHz, which in turn requires WPA 3 without transition mode " +
+					"(or `wpa3_enhanced_192`) and `pmf_mode` set to `required`. Follows `wlan_band` when not set. " +
+					"Requires controller version 7.0 or higher.",
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"2g", "5g", "6g"}, false),
+				},
+			},
+			"dtim_2g": {
+				Description:  "The DTIM period of the 2G radio (1-255). Use `0` for the controller default. `dtim_2g` and `dtim_5g` must be set together.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 255),
+			},
+			"dtim_5g": {
+				Description:  "The DTIM period of the 5G radio (1-255). Use `0` for the controller default. `dtim_2g` and `dtim_5g` must be set together.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 255),
+			},
+			"dtim_6g": {
+				Description:  "The DTIM period of the 6G radio (1-255), only used together with `dtim_2g` and `dtim_5g`. Requires controller version 7.0 or higher.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 255),
+			},
+			"group_rekey": {
+				Description:  "The interval in seconds at which the group key is rotated (60-86400).",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3600,
+				ValidateFunc: validation.IntBetween(60, 86400),
+			},
+			"radius_mac_auth_enabled": {
+				Description: "Authenticate client MAC addresses against the RADIUS server of `radius_profile_id`.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"wpa_psk_radius": {
+				Description: "Look up the passphrases of clients on the RADIUS server of `radius_profile_id` (only valid if " +
+					"`security` is `wpapsk`). Valid values are `disabled`, `optional` to fall back to the passphrases of the " +
+					"network and `required`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "disabled",
+				ValidateFunc: validation.StringInSlice([]string{"disabled", "optional", "required"}, false),
+			},
+			"radius_mac_auth_format": {
+				Description:  "The format of the MAC address sent as the RADIUS user name (only valid if `radius_mac_auth_enabled` is `true`). Valid values are `none_lower`, `hyphen_lower`, `colon_lower`, `none_upper`, `hyphen_upper` and `colon_upper`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "none_lower",
+				ValidateFunc: validation.StringInSlice(wlanValidRADIUSMACAuthFormats, false),
+			},
+			"radius_mac_auth_empty_password": {
+				Description: "Send an empty RADIUS password instead of the MAC address (only valid if `radius_mac_auth_enabled` is `true`).",
+				Type:        schema.TypeBool,
+				Optional:    true,
 			},
 			"network_id": {
 				Description: "ID of the network for this SSID",
@@ -229,13 +362,18 @@ func resourceWLAN() *schema.Resource {
 				Optional:    true,
 			},
 			"ap_group_ids": {
-				Description: "IDs of the AP groups to use for this network.",
+				Description: "IDs of the AP groups to use for this network. You can manage these with the `unifi_ap_group` resource or look them up with the `unifi_ap_group` data source.",
 				Type:        schema.TypeSet,
 				Optional:    true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
 			},
+			"dpi_group_id": {
+				Description: "ID of the DPI group restricting the traffic of this network. You can manage these with the `unifi_dpi_group` resource.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -250,27 +388,41 @@ func resourceWLANGetResourceData(d *schema.ResourceData, meta interface{}) (*uni
 		passphrase = ""
 	}
 
+	// combinations of these are validated at plan time in resourceWLANCustomizeDiff
 	pmf := d.Get("pmf_mode").(string)
 	wpa3 := d.Get("wpa3_support").(bool)
 	wpa3Transition := d.Get("wpa3_transition").(bool)
-	switch security {
-	case "wpapsk":
-		// nothing
-	default:
-		if wpa3 || wpa3Transition {
-			return nil, fmt.Errorf("wpa3_support and wpa3_transition are only valid for security type wpapsk")
+	wpa3FastRoaming := d.Get("wpa3_fast_roaming").(bool)
+	wpa3Enhanced192 := d.Get("wpa3_enhanced_192").(bool)
+
+	saeGroups, err := setToIntSlice(d.Get("sae_groups").(*schema.Set))
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert sae_groups to int slice: %w", err)
+	}
+
+	var wlanBands []string
+	// wlan_bands is computed from wlan_band when not configured
+	if !d.GetRawConfig().GetAttr("wlan_bands").IsNull() {
+		wlanBands, err = setToStringSlice(d.Get("wlan_bands").(*schema.Set))
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert wlan_bands to string slice: %w", err)
 		}
 	}
-	if v := c.ControllerVersion(); v.LessThanOrEqual(controllerVersionWPA3) {
+
+	v := c.ControllerVersion()
+	if v.LessThanOrEqual(controllerVersionWPA3) {
 		if wpa3 || wpa3Transition {
 			return nil, fmt.Errorf("WPA 3 support is not available on controller version %q, you must be on %q or higher", v, controllerVersionWPA3)
 		}
 	}
-
-	if wpa3Transition && pmf == "disabled" {
-		return nil, fmt.Errorf("WPA 3 transition mode requires pmf_mode to be turned on.")
-	} else if wpa3 && !wpa3Transition && pmf != "required" {
-		return nil, fmt.Errorf("For WPA 3 you must set pmf_mode to required.")
+	if v.LessThan(controllerV7) {
+		config := d.GetRawConfig()
+		if wpa3FastRoaming || wpa3Enhanced192 || !config.GetAttr("sae_groups").IsNull() || !config.GetAttr("sae_anti_clogging").IsNull() {
+			return nil, fmt.Errorf("wpa3_fast_roaming, wpa3_enhanced_192, sae_groups and sae_anti_clogging are not available on controller version %q, you must be on %q or higher", v, controllerV7)
+		}
+		if len(wlanBands) > 0 || !config.GetAttr("dtim_6g").IsNull() {
+			return nil, fmt.Errorf("wlan_bands and dtim_6g are not available on controller version %q, you must be on %q or higher", v, controllerV7)
+		}
 	}
 
 	macFilterEnabled := d.Get("mac_filter_enabled").(bool)
@@ -289,6 +441,12 @@ func resourceWLANGetResourceData(d *schema.ResourceData, meta interface{}) (*uni
 		return nil, err
 	}
 	wlanBand := d.Get("wlan_band").(string)
+	if len(wlanBands) > 0 {
+		wlanBand = wlanBandFromBands(wlanBands)
+	} else if v.GreaterThanOrEqual(controllerV7) {
+		// newer controllers use the list of bands, so keep it in sync with wlan_band
+		wlanBands = wlanBandsFromBand(wlanBand)
+	}
 
 	schedule, err := listToSchedules(d.Get("schedule").([]interface{}))
 	if err != nil {
@@ -304,6 +462,11 @@ func resourceWLANGetResourceData(d *schema.ResourceData, meta interface{}) (*uni
 		minrateSettingPreference = "manual"
 	}
 
+	dtimMode := "default"
+	if d.Get("dtim_2g").(int) != 0 || d.Get("dtim_5g").(int) != 0 {
+		dtimMode = "custom"
+	}
+
 	return &unifi.WLAN{
 		Name:                    d.Get("name").(string),
 		XPassphrase:             passphrase,
@@ -315,6 +478,10 @@ func resourceWLANGetResourceData(d *schema.ResourceData, meta interface{}) (*uni
 		Security:                security,
 		WPA3Support:             wpa3,
 		WPA3Transition:          wpa3Transition,
+		WPA3FastRoaming:         wpa3FastRoaming,
+		WPA3Enhanced192:         wpa3Enhanced192,
+		SaeGroups:               saeGroups,
+		SaeAntiClogging:         d.Get("sae_anti_clogging").(int),
 		MulticastEnhanceEnabled: d.Get("multicast_enhance").(bool),
 		MACFilterEnabled:        macFilterEnabled,
 		MACFilterList:           macFilterList,
@@ -323,7 +490,15 @@ func resourceWLANGetResourceData(d *schema.ResourceData, meta interface{}) (*uni
 		ScheduleWithDuration:    schedule,
 		ScheduleEnabled:         len(schedule) > 0,
 		WLANBand:                wlanBand,
+		WLANBands:               wlanBands,
 		PMFMode:                 pmf,
+		DPIEnabled:              d.Get("dpi_group_id").(string) != "",
+		DPIgroupID:              d.Get("dpi_group_id").(string),
+
+		RADIUSMACAuthEnabled:      d.Get("radius_mac_auth_enabled").(bool),
+		RADIUSMACaclFormat:        d.Get("radius_mac_auth_format").(string),
+		RADIUSMACaclEmptyPassword: d.Get("radius_mac_auth_empty_password").(bool),
+		WPAPskRADIUS:              d.Get("wpa_psk_radius").(string),
 
 		// TODO: add to schema
 		WPAEnc:             "ccmp",
@@ -331,8 +506,11 @@ func resourceWLANGetResourceData(d *schema.ResourceData, meta interface{}) (*uni
 		Enabled:            true,
 		NameCombineEnabled: true,
 
-		GroupRekey:         3600,
-		DTIMMode:           "default",
+		GroupRekey:         d.Get("group_rekey").(int),
+		DTIMMode:           dtimMode,
+		DTIMNg:             d.Get("dtim_2g").(int),
+		DTIMNa:             d.Get("dtim_5g").(int),
+		DTIM6E:             d.Get("dtim_6g").(int),
 		No2GhzOui:          d.Get("no2ghz_oui").(bool),
 		L2Isolation:        d.Get("l2_isolation").(bool),
 		ProxyArp:           d.Get("proxy_arp").(bool),
@@ -342,14 +520,138 @@ func resourceWLANGetResourceData(d *schema.ResourceData, meta interface{}) (*uni
 
 		MinrateSettingPreference: minrateSettingPreference,
 
-		MinrateNgEnabled:      d.Get("minimum_data_rate_2g_kbps").(int) != 0,
-		MinrateNgDataRateKbps: d.Get("minimum_data_rate_2g_kbps").(int),
+		MinrateNgEnabled:          d.Get("minimum_data_rate_2g_kbps").(int) != 0,
+		MinrateNgDataRateKbps:     d.Get("minimum_data_rate_2g_kbps").(int),
+		MinrateNgAdvertisingRates: d.Get("minimum_data_rate_2g_advertising_rates").(bool),
 
-		MinrateNaEnabled:      d.Get("minimum_data_rate_5g_kbps").(int) != 0,
-		MinrateNaDataRateKbps: d.Get("minimum_data_rate_5g_kbps").(int),
+		MinrateNaEnabled:          d.Get("minimum_data_rate_5g_kbps").(int) != 0,
+		MinrateNaDataRateKbps:     d.Get("minimum_data_rate_5g_kbps").(int),
+		MinrateNaAdvertisingRates: d.Get("minimum_data_rate_5g_advertising_rates").(bool),
 	}, nil
 }
 
+// wlanBandFromBands returns the wlan_band equivalent of a list of bands, 6 GHz has no equivalent.
+func wlanBandFromBands(bands []string) string {
+	var has2g, has5g bool
+	for _, b := range bands {
+		switch b {
+		case "2g":
+			has2g = true
+		case "5g":
+			has5g = true
+		}
+	}
+	switch {
+	case has2g && has5g:
+		return "both"
+	case has2g:
+		return "2g"
+	case has5g:
+		return "5g"
+	}
+	return ""
+}
+
+// wlanBandsFromBand returns the list of bands equivalent to a wlan_band.
+func wlanBandsFromBand(band string) []string {
+	switch band {
+	case "2g", "5g":
+		return []string{band}
+	}
+	return []string{"2g", "5g"}
+}
+
+func resourceWLANCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	config := diff.GetRawConfig()
+	configured := func(key string) bool {
+		return !config.IsNull() && !config.GetAttr(key).IsNull()
+	}
+
+	security := diff.Get("security").(string)
+	pmf := diff.Get("pmf_mode").(string)
+	wpa3 := diff.Get("wpa3_support").(bool)
+	wpa3Transition := diff.Get("wpa3_transition").(bool)
+	wpa3Enhanced192 := diff.Get("wpa3_enhanced_192").(bool)
+
+	if security != "wpapsk" && (wpa3 || wpa3Transition) {
+		return fmt.Errorf("wpa3_support and wpa3_transition are only valid for security type wpapsk")
+	}
+	if wpa3Transition && pmf == "disabled" {
+		return fmt.Errorf("WPA 3 transition mode requires pmf_mode to be turned on.")
+	} else if wpa3 && !wpa3Transition && pmf != "required" {
+		return fmt.Errorf("For WPA 3 you must set pmf_mode to required.")
+	}
+	if diff.Get("wpa3_fast_roaming").(bool) && (!wpa3 || !diff.Get("fast_roaming_enabled").(bool)) {
+		return fmt.Errorf("wpa3_fast_roaming requires wpa3_support and fast_roaming_enabled")
+	}
+	if wpa3Enhanced192 && (security != "wpaeap" || pmf != "required") {
+		return fmt.Errorf("wpa3_enhanced_192 requires security wpaeap and pmf_mode required")
+	}
+	if !wpa3 && (configured("sae_groups") || configured("sae_anti_clogging")) {
+		return fmt.Errorf("sae_groups and sae_anti_clogging require wpa3_support")
+	}
+
+	if keys := diff.Get("private_preshared_key").(*schema.Set).List(); len(keys) > 0 {
+		if security != "wpapsk" || (wpa3 && !wpa3Transition) {
+			return fmt.Errorf("private_preshared_key is only valid for security type wpapsk without WPA 3 only")
+		}
+		passphrases := map[string]bool{}
+		for _, k := range keys {
+			// unknown passphrases are empty at plan time
+			passphrase := k.(map[string]interface{})["passphrase"].(string)
+			if passphrase != "" && passphrases[passphrase] {
+				return fmt.Errorf("private_preshared_key passphrases must be unique")
+			}
+			passphrases[passphrase] = true
+		}
+	}
+
+	if configured("wlan_bands") {
+		if configured("wlan_band") {
+			return fmt.Errorf("only one of wlan_band and wlan_bands can be set")
+		}
+		if diff.Get("wlan_bands").(*schema.Set).Contains("6g") && (pmf != "required" || !(wpa3 && !wpa3Transition || wpa3Enhanced192)) {
+			return fmt.Errorf("6 GHz requires WPA 3 without transition mode or wpa3_enhanced_192, and pmf_mode required")
+		}
+	} else if diff.HasChange("wlan_band") {
+		// the bands follow wlan_band and are read back after the update
+		if err := diff.SetNewComputed("wlan_bands"); err != nil {
+			return err
+		}
+	}
+
+	if (diff.Get("dtim_2g").(int) != 0 || diff.Get("dtim_5g").(int) != 0 || configured("dtim_6g")) &&
+		(diff.Get("dtim_2g").(int) == 0 || diff.Get("dtim_5g").(int) == 0) {
+		return fmt.Errorf("you must set dtim_2g and dtim_5g if setting any DTIM period")
+	}
+
+	for _, band := range []string{"2g", "5g"} {
+		if diff.Get("minimum_data_rate_"+band+"_advertising_rates").(bool) && diff.Get("minimum_data_rate_"+band+"_kbps").(int) == 0 {
+			return fmt.Errorf("minimum_data_rate_%s_advertising_rates requires minimum_data_rate_%s_kbps", band, band)
+		}
+	}
+
+	if diff.Get("radius_mac_auth_enabled").(bool) {
+		// the profile may be created in the same apply, so only check a known value
+		if diff.NewValueKnown("radius_profile_id") && diff.Get("radius_profile_id").(string) == "" {
+			return fmt.Errorf("radius_mac_auth_enabled requires radius_profile_id")
+		}
+	} else if configured("radius_mac_auth_format") || diff.Get("radius_mac_auth_empty_password").(bool) {
+		return fmt.Errorf("radius_mac_auth_format and radius_mac_auth_empty_password require radius_mac_auth_enabled")
+	}
+
+	if diff.Get("wpa_psk_radius").(string) != "disabled" {
+		if security != "wpapsk" {
+			return fmt.Errorf("wpa_psk_radius is only valid for security type wpapsk")
+		}
+		if diff.NewValueKnown("radius_profile_id") && diff.Get("radius_profile_id").(string) == "" {
+			return fmt.Errorf("wpa_psk_radius requires radius_profile_id")
+		}
+	}
+
+	return nil
+}
+
 func resourceWLANCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c := meta.(*client)
 
@@ -363,6 +665,12 @@ func resourceWLANCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		site = c.site
 	}
 
+	req.SaePsk, err = resourceWLANGetPrivatePresharedKeys(ctx, d, meta, site)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.SaePskVLANRequired = len(req.SaePsk) > 0
+
 	resp, err := c.c.CreateWLAN(ctx, site, req)
 	if err != nil {
 		return diag.FromErr(err)
@@ -370,7 +678,11 @@ func resourceWLANCreate(ctx context.Context, d *schema.ResourceData, meta interf
 
 	d.SetId(resp.ID)
 
-	return resourceWLANSetResourceData(resp, d, meta, site)
+	diags := resourceWLANSetResourceData(resp, d, meta, site)
+	if diags.HasError() {
+		return diags
+	}
+	return resourceWLANReadPrivatePresharedKeys(ctx, resp, d, meta, site)
 }
 
 func resourceWLANSetResourceData(resp *unifi.WLAN, d *schema.ResourceData, meta interface{}, site string) diag.Diagnostics {
@@ -415,6 +727,32 @@ func resourceWLANSetResourceData(resp *unifi.WLAN, d *schema.ResourceData, meta
 	d.Set("radius_profile_id", resp.RADIUSProfileID)
 	d.Set("schedule", schedule)
 	d.Set("wlan_band", resp.WLANBand)
+	d.Set("wlan_bands", stringSliceToSet(resp.WLANBands))
+	d.Set("wpa3_fast_roaming", resp.WPA3FastRoaming)
+	d.Set("wpa3_enhanced_192", resp.WPA3Enhanced192)
+	d.Set("sae_groups", intSliceToSet(resp.SaeGroups))
+	d.Set("sae_anti_clogging", resp.SaeAntiClogging)
+	d.Set("group_rekey", resp.GroupRekey)
+	if resp.DTIMMode == "custom" {
+		d.Set("dtim_2g", resp.DTIMNg)
+		d.Set("dtim_5g", resp.DTIMNa)
+	} else {
+		d.Set("dtim_2g", 0)
+		d.Set("dtim_5g", 0)
+	}
+	d.Set("dtim_6g", resp.DTIM6E)
+	d.Set("radius_mac_auth_enabled", resp.RADIUSMACAuthEnabled)
+	radiusMACAuthFormat := resp.RADIUSMACaclFormat
+	if radiusMACAuthFormat == "" {
+		radiusMACAuthFormat = "none_lower"
+	}
+	d.Set("radius_mac_auth_format", radiusMACAuthFormat)
+	d.Set("radius_mac_auth_empty_password", resp.RADIUSMACaclEmptyPassword)
+	wpaPskRADIUS := resp.WPAPskRADIUS
+	if wpaPskRADIUS == "" {
+		wpaPskRADIUS = "disabled"
+	}
+	d.Set("wpa_psk_radius", wpaPskRADIUS)
 	d.Set("no2ghz_oui", resp.No2GhzOui)
 	d.Set("l2_isolation", resp.L2Isolation)
 	d.Set("proxy_arp", resp.ProxyArp)
@@ -423,16 +761,25 @@ func resourceWLANSetResourceData(resp *unifi.WLAN, d *schema.ResourceData, meta
 	d.Set("fast_roaming_enabled", resp.FastRoamingEnabled)
 	d.Set("ap_group_ids", apGroupIDs)
 	d.Set("network_id", resp.NetworkID)
+	if resp.DPIEnabled {
+		d.Set("dpi_group_id", resp.DPIgroupID)
+	} else {
+		d.Set("dpi_group_id", "")
+	}
 	d.Set("pmf_mode", resp.PMFMode)
 	if resp.MinrateSettingPreference != "auto" && resp.MinrateNgEnabled {
 		d.Set("minimum_data_rate_2g_kbps", resp.MinrateNgDataRateKbps)
+		d.Set("minimum_data_rate_2g_advertising_rates", resp.MinrateNgAdvertisingRates)
 	} else {
 		d.Set("minimum_data_rate_2g_kbps", 0)
+		d.Set("minimum_data_rate_2g_advertising_rates", false)
 	}
 	if resp.MinrateSettingPreference != "auto" && resp.MinrateNaEnabled {
 		d.Set("minimum_data_rate_5g_kbps", resp.MinrateNaDataRateKbps)
+		d.Set("minimum_data_rate_5g_advertising_rates", resp.MinrateNaAdvertisingRates)
 	} else {
 		d.Set("minimum_data_rate_5g_kbps", 0)
+		d.Set("minimum_data_rate_5g_advertising_rates", false)
 	}
 
 	return nil
@@ -456,7 +803,11 @@ func resourceWLANRead(ctx context.Context, d *schema.ResourceData, meta interfac
 		return diag.FromErr(err)
 	}
 
-	return resourceWLANSetResourceData(resp, d, meta, site)
+	diags := resourceWLANSetResourceData(resp, d, meta, site)
+	if diags.HasError() {
+		return diags
+	}
+	return resourceWLANReadPrivatePresharedKeys(ctx, resp, d, meta, site)
 }
 
 func resourceWLANUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -474,12 +825,26 @@ func resourceWLANUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 	req.SiteID = site
 
-	resp, err := c.c.UpdateWLAN(ctx, site, req)
+	req.SaePsk, err = resourceWLANGetPrivatePresharedKeys(ctx, d, meta, site)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.SaePskVLANRequired = len(req.SaePsk) > 0
+
+	// sae_psk is omitted when empty, so removing all keys has to be sent explicitly
+	resp, err := c.c.UpdateWLANFields(ctx, site, req, map[string]interface{}{
+		"sae_psk":               req.SaePsk,
+		"sae_psk_vlan_required": req.SaePskVLANRequired,
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	return resourceWLANSetResourceData(resp, d, meta, site)
+	diags := resourceWLANSetResourceData(resp, d, meta, site)
+	if diags.HasError() {
+		return diags
+	}
+	return resourceWLANReadPrivatePresharedKeys(ctx, resp, d, meta, site)
 }
 
 func resourceWLANDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -498,6 +863,114 @@ func resourceWLANDelete(ctx context.Context, d *schema.ResourceData, meta interf
 	return diag.FromErr(err)
 }
 
+// wlanNetworkVLAN returns the VLAN clients of a network are tagged with, 0 for untagged networks.
+func wlanNetworkVLAN(n unifi.Network) int {
+	if !n.VLANEnabled {
+		return 0
+	}
+	return n.VLAN
+}
+
+// wlanPrivatePresharedKeyNetwork reports whether clients of a private preshared key can be placed in the network.
+func wlanPrivatePresharedKeyNetwork(n unifi.Network) bool {
+	switch n.Purpose {
+	case "corporate", "guest", "vlan-only":
+		return true
+	}
+	return false
+}
+
+// resourceWLANGetPrivatePresharedKeys converts the private_preshared_key blocks in to keys, the controller
+// maps keys to VLANs so the VLAN of each network is looked up.
+func resourceWLANGetPrivatePresharedKeys(ctx context.Context, d *schema.ResourceData, meta interface{}, site string) ([]unifi.WLANSaePsk, error) {
+	c := meta.(*client)
+
+	keys := d.Get("private_preshared_key").(*schema.Set).List()
+	if len(keys) == 0 {
+		return []unifi.WLANSaePsk{}, nil
+	}
+
+	networks, err := c.c.ListNetwork(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	psks := make([]unifi.WLANSaePsk, 0, len(keys))
+	for _, k := range keys {
+		key := k.(map[string]interface{})
+		networkID := key["network_id"].(string)
+
+		var network *unifi.Network
+		for i := range networks {
+			if networks[i].ID == networkID {
+				network = &networks[i]
+				break
+			}
+		}
+		if network == nil {
+			return nil, fmt.Errorf("unable to find network %q of private_preshared_key", networkID)
+		}
+
+		// the network is only known to the controller by its VLAN, so it has to be unambiguous
+		vlan := wlanNetworkVLAN(*network)
+		for _, n := range networks {
+			if n.ID != network.ID && wlanPrivatePresharedKeyNetwork(n) && wlanNetworkVLAN(n) == vlan {
+				return nil, fmt.Errorf("network %q of private_preshared_key shares VLAN %d with network %q (%s)", networkID, vlan, n.ID, n.Name)
+			}
+		}
+
+		psks = append(psks, unifi.WLANSaePsk{
+			Psk:  key["passphrase"].(string),
+			VLAN: vlan,
+		})
+	}
+
+	return psks, nil
+}
+
+// resourceWLANReadPrivatePresharedKeys sets the private_preshared_key blocks from the keys of the WLAN. The
+// network of each key is looked up by VLAN, preferring the network already in state for the passphrase.
+func resourceWLANReadPrivatePresharedKeys(ctx context.Context, resp *unifi.WLAN, d *schema.ResourceData, meta interface{}, site string) diag.Diagnostics {
+	c := meta.(*client)
+
+	keys := []interface{}{}
+	if len(resp.SaePsk) == 0 {
+		d.Set("private_preshared_key", keys)
+		return nil
+	}
+
+	networks, err := c.c.ListNetwork(ctx, site)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	current := map[string]string{}
+	for _, k := range d.Get("private_preshared_key").(*schema.Set).List() {
+		key := k.(map[string]interface{})
+		current[key["passphrase"].(string)] = key["network_id"].(string)
+	}
+
+	for _, psk := range resp.SaePsk {
+		networkID := ""
+		for _, n := range networks {
+			if !wlanPrivatePresharedKeyNetwork(n) || wlanNetworkVLAN(n) != psk.VLAN {
+				continue
+			}
+			if networkID == "" || n.ID == current[psk.Psk] {
+				networkID = n.ID
+			}
+		}
+
+		keys = append(keys, map[string]interface{}{
+			"passphrase": psk.Psk,
+			"network_id": networkID,
+		})
+	}
+	d.Set("private_preshared_key", keys)
+
+	return nil
+}
+
 func listToSchedules(list []interface{}) ([]unifi.WLANScheduleWithDuration, error) {
 	schedules := make([]unifi.WLANScheduleWithDuration, 0, len(list))
 	for _, item := range list {
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccWLANGroup_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { preCheck(t) },
+		ProviderFactories: providerFactories,
+		// TODO: CheckDestroy: ,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWLANGroupConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_wlan_group.test", "name", "tfacc"),
+				),
+			},
+			importStep("unifi_wlan_group.test"),
+			{
+				Config: testAccWLANGroupConfig_renamed,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_wlan_group.test", "name", "tfacc-renamed"),
+				),
+			},
+			importStep("unifi_wlan_group.test"),
+		},
+	})
+}
+
+const testAccWLANGroupConfig = `
+resource "unifi_wlan_group" "test" {
+	name = "tfacc"
+}
+`
+
+const testAccWLANGroupConfig_renamed = `
+resource "unifi_wlan_group" "test" {
+	name = "tfacc-renamed"
+}
+`
@@ -3,6 +3,7 @@
 import (
 	"fmt"
 	"net"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -398,6 +399,308 @@ func TestAccWLAN_minimum_data_rate(t *testing.T) {
 	})
 }
 
+func TestAccWLAN_dpi_group(t *testing.T) {
+	name := acctest.RandomWithPrefix("tfacc")
+	subnet, vlan := getTestVLAN(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { preCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWLANConfig_dpi_group(name, subnet, vlan, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("unifi_wlan.test", "dpi_group_id", "unifi_dpi_group.test", "id"),
+				),
+			},
+			importStep("unifi_wlan.test"),
+			{
+				Config: testAccWLANConfig_dpi_group(name, subnet, vlan, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_wlan.test", "dpi_group_id", ""),
+				),
+			},
+			importStep("unifi_wlan.test"),
+		},
+	})
+}
+
+func TestAccWLAN_wpa3_options(t *testing.T) {
+	name := acctest.RandomWithPrefix("tfacc")
+	subnet, vlan := getTestVLAN(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			preCheck(t)
+			preCheckMinVersion(t, controllerV7)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWLANConfig_wpa3_options(name, subnet, vlan, `
+	fast_roaming_enabled = true
+	wpa3_fast_roaming    = true
+	sae_groups           = [19, 20]
+	sae_anti_clogging    = 10
+	group_rekey          = 7200
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_wlan.test", "wpa3_fast_roaming", "true"),
+					resource.TestCheckResourceAttr("unifi_wlan.test", "sae_groups.#", "2"),
+					resource.TestCheckResourceAttr("unifi_wlan.test", "sae_anti_clogging", "10"),
+					resource.TestCheckResourceAttr("unifi_wlan.test", "group_rekey", "7200"),
+				),
+			},
+			importStep("unifi_wlan.test"),
+			{
+				Config: testAccWLANConfig_wpa3_options(name, subnet, vlan, `
+	wlan_bands = ["2g", "5g", "6g"]
+	dtim_2g    = 1
+	dtim_5g    = 3
+	dtim_6g    = 3
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_wlan.test", "wpa3_fast_roaming", "false"),
+					resource.TestCheckResourceAttr("unifi_wlan.test", "wlan_bands.#", "3"),
+					resource.TestCheckResourceAttr("unifi_wlan.test", "dtim_2g", "1"),
+					resource.TestCheckResourceAttr("unifi_wlan.test", "dtim_5g", "3"),
+					resource.TestCheckResourceAttr("unifi_wlan.test", "dtim_6g", "3"),
+				),
+			},
+			importStep("unifi_wlan.test"),
+			{
+				// without wlan_bands, the bands follow wlan_band
+				Config: testAccWLANConfig_wpa3_options(name, subnet, vlan, `
+	wlan_band = "5g"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_wlan.test", "wlan_band", "5g"),
+					resource.TestCheckResourceAttr("unifi_wlan.test", "wlan_bands.#", "1"),
+					resource.TestCheckTypeSetElemAttr("unifi_wlan.test", "wlan_bands.*", "5g"),
+				),
+			},
+			importStep("unifi_wlan.test"),
+		},
+	})
+}
+
+func TestAccWLAN_minimum_data_rate_advertising_rates(t *testing.T) {
+	subnet, vlan := getTestVLAN(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { preCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWLANConfig_minimum_data_rate_advertising_rates(subnet, vlan, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_wlan.test", "minimum_data_rate_2g_advertising_rates", "true"),
+					resource.TestCheckResourceAttr("unifi_wlan.test", "minimum_data_rate_5g_advertising_rates", "true"),
+				),
+			},
+			importStep("unifi_wlan.test"),
+			{
+				Config: testAccWLANConfig_minimum_data_rate_advertising_rates(subnet, vlan, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_wlan.test", "minimum_data_rate_2g_advertising_rates", "false"),
+					resource.TestCheckResourceAttr("unifi_wlan.test", "minimum_data_rate_5g_advertising_rates", "false"),
+				),
+			},
+			importStep("unifi_wlan.test"),
+		},
+	})
+}
+
+func TestAccWLAN_radius_mac_auth(t *testing.T) {
+	subnet, vlan := getTestVLAN(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { preCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWLANConfig_radius_mac_auth(subnet, vlan, "hyphen_upper"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_wlan.test", "radius_mac_auth_enabled", "true"),
+					resource.TestCheckResourceAttr("unifi_wlan.test", "radius_mac_auth_format", "hyphen_upper"),
+				),
+			},
+			importStep("unifi_wlan.test"),
+			{
+				Config: testAccWLANConfig_radius_mac_auth(subnet, vlan, "colon_lower"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_wlan.test", "radius_mac_auth_format", "colon_lower"),
+				),
+			},
+			importStep("unifi_wlan.test"),
+		},
+	})
+}
+
+func TestAccWLAN_invalidCombinations(t *testing.T) {
+	name := acctest.RandomWithPrefix("tfacc")
+	subnet, vlan := getTestVLAN(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { preCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWLANConfig_wpa3_options(name, subnet, vlan, `
+	wpa3_fast_roaming = true
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("wpa3_fast_roaming requires wpa3_support and fast_roaming_enabled"),
+			},
+			{
+				Config: testAccWLANConfig_wpa3_options(name, subnet, vlan, `
+	wpa3_enhanced_192 = true
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("wpa3_enhanced_192 requires security wpaeap and pmf_mode required"),
+			},
+			{
+				Config: testAccWLANConfig_wpa3_options(name, subnet, vlan, `
+	wlan_band  = "2g"
+	wlan_bands = ["2g"]
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("only one of wlan_band and wlan_bands can be set"),
+			},
+			{
+				Config:      testAccWLANConfig_wpa3_6g_transition(name, subnet, vlan),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("6 GHz requires WPA 3 without transition mode"),
+			},
+			{
+				Config: testAccWLANConfig_wpa3_options(name, subnet, vlan, `
+	dtim_2g = 3
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("you must set dtim_2g and dtim_5g if setting any DTIM period"),
+			},
+			{
+				Config: testAccWLANConfig_wpa3_options(name, subnet, vlan, `
+	radius_mac_auth_enabled = true
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("radius_mac_auth_enabled requires radius_profile_id"),
+			},
+			{
+				Config: testAccWLANConfig_wpa3_options(name, subnet, vlan, `
+	wpa_psk_radius = "required"
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("wpa_psk_radius requires radius_profile_id"),
+			},
+		},
+	})
+}
+
+func TestAccWLAN_wpa_psk_radius(t *testing.T) {
+	subnet, vlan := getTestVLAN(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { preCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWLANConfig_wpa_psk_radius(subnet, vlan, "optional"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_wlan.test", "wpa_psk_radius", "optional"),
+				),
+			},
+			importStep("unifi_wlan.test"),
+			{
+				Config: testAccWLANConfig_wpa_psk_radius(subnet, vlan, "disabled"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_wlan.test", "wpa_psk_radius", "disabled"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWLAN_private_preshared_key(t *testing.T) {
+	name := acctest.RandomWithPrefix("tfacc")
+	subnet1, vlan1 := getTestVLAN(t)
+	subnet2, vlan2 := getTestVLAN(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { preCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWLANConfig_private_preshared_key(name, subnet1, vlan1, subnet2, vlan2, `
+	private_preshared_key {
+		passphrase = "tenant-one-key"
+		network_id = unifi_network.test1.id
+	}
+
+	private_preshared_key {
+		passphrase = "tenant-two-key"
+		network_id = unifi_network.test2.id
+	}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_wlan.test", "private_preshared_key.#", "2"),
+				),
+			},
+			importStep("unifi_wlan.test"),
+			{
+				// reordering the keys does not cause a diff
+				Config: testAccWLANConfig_private_preshared_key(name, subnet1, vlan1, subnet2, vlan2, `
+	private_preshared_key {
+		passphrase = "tenant-two-key"
+		network_id = unifi_network.test2.id
+	}
+
+	private_preshared_key {
+		passphrase = "tenant-one-key"
+		network_id = unifi_network.test1.id
+	}
+`),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccWLANConfig_private_preshared_key(name, subnet1, vlan1, subnet2, vlan2, `
+	private_preshared_key {
+		passphrase = "tenant-one-key"
+		network_id = unifi_network.test2.id
+	}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_wlan.test", "private_preshared_key.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("unifi_wlan.test", "private_preshared_key.*.network_id", "unifi_network.test2", "id"),
+				),
+			},
+			importStep("unifi_wlan.test"),
+			{
+				Config: testAccWLANConfig_private_preshared_key(name, subnet1, vlan1, subnet2, vlan2, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_wlan.test", "private_preshared_key.#", "0"),
+				),
+			},
+			importStep("unifi_wlan.test"),
+			{
+				Config: testAccWLANConfig_private_preshared_key(name, subnet1, vlan1, subnet2, vlan2, `
+	private_preshared_key {
+		passphrase = "tenant-one-key"
+		network_id = unifi_network.test1.id
+	}
+
+	private_preshared_key {
+		passphrase = "tenant-one-key"
+		network_id = unifi_network.test2.id
+	}
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("private_preshared_key passphrases must be unique"),
+			},
+		},
+	})
+}
+
 func testAccWLANConfig_wpapsk(subnet *net.IPNet, vlan int, pmf string) string {
 	return fmt.Sprintf(`
 data "unifi_ap_group" "default" {}
@@ -766,3 +1069,229 @@ func testAccWLANConfig_minimum_data_rate(subnet *net.IPNet, vlan int, min2g int,
 }
 `, subnet, vlan, min2g, min5g)
 }
+
+func testAccWLANConfig_dpi_group(name string, subnet *net.IPNet, vlan int, restricted bool) string {
+	dpiGroupID := "null"
+	if restricted {
+		dpiGroupID = "unifi_dpi_group.test.id"
+	}
+
+	return fmt.Sprintf(`
+data "unifi_ap_group" "default" {}
+
+data "unifi_user_group" "default" {}
+
+resource "unifi_network" "test" {
+	name    = "%[1]s"
+	purpose = "corporate"
+	subnet  = "%[2]s"
+	vlan_id = %[3]d
+}
+
+resource "unifi_dpi_app" "test" {
+	name         = "%[1]s"
+	blocked      = true
+	category_ids = [4]
+}
+
+resource "unifi_dpi_group" "test" {
+	name        = "%[1]s"
+	dpi_app_ids = [unifi_dpi_app.test.id]
+}
+
+resource "unifi_wlan" "test" {
+	name          = "%[1]s"
+	network_id    = unifi_network.test.id
+	passphrase    = "12345678"
+	ap_group_ids  = [data.unifi_ap_group.default.id]
+	user_group_id = data.unifi_user_group.default.id
+	security      = "wpapsk"
+	dpi_group_id  = %[4]s
+}
+`, name, subnet, vlan, dpiGroupID)
+}
+
+func testAccWLANConfig_wpa3_options(name string, subnet *net.IPNet, vlan int, options string) string {
+	return fmt.Sprintf(`
+data "unifi_ap_group" "default" {}
+
+data "unifi_user_group" "default" {}
+
+resource "unifi_network" "test" {
+	name    = "%[1]s"
+	purpose = "corporate"
+	subnet  = "%[2]s"
+	vlan_id = %[3]d
+}
+
+resource "unifi_wlan" "test" {
+	name          = "%[1]s"
+	network_id    = unifi_network.test.id
+	passphrase    = "12345678"
+	ap_group_ids  = [data.unifi_ap_group.default.id]
+	user_group_id = data.unifi_user_group.default.id
+	security      = "wpapsk"
+	wpa3_support  = true
+	pmf_mode      = "required"
+%[4]s
+}
+`, name, subnet, vlan, options)
+}
+
+func testAccWLANConfig_wpa3_6g_transition(name string, subnet *net.IPNet, vlan int) string {
+	return fmt.Sprintf(`
+data "unifi_ap_group" "default" {}
+
+data "unifi_user_group" "default" {}
+
+resource "unifi_network" "test" {
+	name    = "%[1]s"
+	purpose = "corporate"
+	subnet  = "%[2]s"
+	vlan_id = %[3]d
+}
+
+resource "unifi_wlan" "test" {
+	name            = "%[1]s"
+	network_id      = unifi_network.test.id
+	passphrase      = "12345678"
+	ap_group_ids    = [data.unifi_ap_group.default.id]
+	user_group_id   = data.unifi_user_group.default.id
+	security        = "wpapsk"
+	wpa3_support    = true
+	wpa3_transition = true
+	pmf_mode        = "required"
+	wlan_bands      = ["5g", "6g"]
+}
+`, name, subnet, vlan)
+}
+
+func testAccWLANConfig_minimum_data_rate_advertising_rates(subnet *net.IPNet, vlan int, advertisingRates bool) string {
+	return fmt.Sprintf(`
+data "unifi_ap_group" "default" {}
+
+data "unifi_user_group" "default" {}
+
+resource "unifi_network" "test" {
+	name    = "tfacc"
+	purpose = "corporate"
+	subnet  = "%[1]s"
+	vlan_id = %[2]d
+}
+
+resource "unifi_wlan" "test" {
+	name          = "tfacc-wpapsk"
+	network_id    = unifi_network.test.id
+	passphrase    = "12345678"
+	ap_group_ids  = [data.unifi_ap_group.default.id]
+	user_group_id = data.unifi_user_group.default.id
+	security      = "wpapsk"
+
+	minimum_data_rate_2g_kbps              = 6000
+	minimum_data_rate_5g_kbps              = 12000
+	minimum_data_rate_2g_advertising_rates = %[3]t
+	minimum_data_rate_5g_advertising_rates = %[3]t
+}
+`, subnet, vlan, advertisingRates)
+}
+
+func testAccWLANConfig_radius_mac_auth(subnet *net.IPNet, vlan int, format string) string {
+	return fmt.Sprintf(`
+data "unifi_ap_group" "default" {}
+
+data "unifi_user_group" "default" {}
+
+data "unifi_radius_profile" "default" {}
+
+resource "unifi_setting_radius" "this" {
+	enabled = true
+	secret  = "securepw"
+}
+
+resource "unifi_network" "test" {
+	name    = "tfacc"
+	purpose = "corporate"
+	subnet  = "%[1]s"
+	vlan_id = %[2]d
+}
+
+resource "unifi_wlan" "test" {
+	name          = "tfacc-wpapsk"
+	network_id    = unifi_network.test.id
+	passphrase    = "12345678"
+	ap_group_ids  = [data.unifi_ap_group.default.id]
+	user_group_id = data.unifi_user_group.default.id
+	security      = "wpapsk"
+
+	radius_profile_id       = data.unifi_radius_profile.default.id
+	radius_mac_auth_enabled = true
+	radius_mac_auth_format  = %[3]q
+}
+`, subnet, vlan, format)
+}
+
+func testAccWLANConfig_wpa_psk_radius(subnet *net.IPNet, vlan int, mode string) string {
+	return fmt.Sprintf(`
+data "unifi_ap_group" "default" {}
+
+data "unifi_user_group" "default" {}
+
+data "unifi_radius_profile" "default" {}
+
+resource "unifi_setting_radius" "this" {
+	enabled = true
+	secret  = "securepw"
+}
+
+resource "unifi_network" "test" {
+	name    = "tfacc"
+	purpose = "corporate"
+	subnet  = "%[1]s"
+	vlan_id = %[2]d
+}
+
+resource "unifi_wlan" "test" {
+	name          = "tfacc-wpapsk"
+	network_id    = unifi_network.test.id
+	passphrase    = "12345678"
+	ap_group_ids  = [data.unifi_ap_group.default.id]
+	user_group_id = data.unifi_user_group.default.id
+	security      = "wpapsk"
+
+	radius_profile_id = data.unifi_radius_profile.default.id
+	wpa_psk_radius    = %[3]q
+}
+`, subnet, vlan, mode)
+}
+
+func testAccWLANConfig_private_preshared_key(name string, subnet1 *net.IPNet, vlan1 int, subnet2 *net.IPNet, vlan2 int, keys string) string {
+	return fmt.Sprintf(`
+data "unifi_ap_group" "default" {}
+
+data "unifi_user_group" "default" {}
+
+resource "unifi_network" "test1" {
+	name    = "%[1]s-1"
+	purpose = "corporate"
+	subnet  = "%[2]s"
+	vlan_id = %[3]d
+}
+
+resource "unifi_network" "test2" {
+	name    = "%[1]s-2"
+	purpose = "corporate"
+	subnet  = "%[4]s"
+	vlan_id = %[5]d
+}
+
+resource "unifi_wlan" "test" {
+	name          = "%[1]s"
+	network_id    = unifi_network.test1.id
+	passphrase    = "12345678"
+	ap_group_ids  = [data.unifi_ap_group.default.id]
+	user_group_id = data.unifi_user_group.default.id
+	security      = "wpapsk"
+%[6]s
+}
+`, name, subnet1, vlan1, subnet2, vlan2, keys)
+}
@@ -0,0 +1,18 @@
+module github.com/paultyng/go-unifi
+
+go 1.18
+
+require (
+	github.com/hashicorp/go-version v1.6.0
+	github.com/iancoleman/strcase v0.2.0
+	github.com/stretchr/testify v1.8.2
+	github.com/tj/assert v0.0.3
+	github.com/ulikunitz/xz v0.5.11
+	github.com/xor-gate/ar v0.0.0-20170530204233-5c72ae81e2b7
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
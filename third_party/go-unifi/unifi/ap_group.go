@@ -0,0 +1,83 @@
+package unifi
+
+import (
+	"context"
+	"fmt"
+)
+
+// just to fix compile issues with the import
+var (
+	_ fmt.Formatter
+	_ context.Context
+)
+
+// This is a v2 API object, so manually coded for now, need to figure out generation...
+
+type APGroup struct {
+	ID string `json:"_id,omitempty"`
+
+	Hidden   bool   `json:"attr_hidden,omitempty"`
+	HiddenID string `json:"attr_hidden_id,omitempty"`
+	NoDelete bool   `json:"attr_no_delete,omitempty"`
+	NoEdit   bool   `json:"attr_no_edit,omitempty"`
+
+	Name       string   `json:"name"`
+	DeviceMACs []string `json:"device_macs"`
+}
+
+func (c *Client) ListAPGroup(ctx context.Context, site string) ([]APGroup, error) {
+	var respBody []APGroup
+
+	err := c.do(ctx, "GET", fmt.Sprintf("%s/site/%s/apgroups", c.apiV2Path, site), nil, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return respBody, nil
+}
+
+func (c *Client) GetAPGroup(ctx context.Context, site, id string) (*APGroup, error) {
+	// the v2 API has no endpoint for a single AP group, so filter the list
+	groups, err := c.ListAPGroup(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, g := range groups {
+		if g.ID == id {
+			return &g, nil
+		}
+	}
+
+	return nil, &NotFoundError{}
+}
+
+func (c *Client) DeleteAPGroup(ctx context.Context, site, id string) error {
+	err := c.do(ctx, "DELETE", fmt.Sprintf("%s/site/%s/apgroups/%s", c.apiV2Path, site, id), struct{}{}, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *Client) CreateAPGroup(ctx context.Context, site string, d *APGroup) (*APGroup, error) {
+	var respBody APGroup
+
+	err := c.do(ctx, "POST", fmt.Sprintf("%s/site/%s/apgroups", c.apiV2Path, site), d, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return &respBody, nil
+}
+
+func (c *Client) UpdateAPGroup(ctx context.Context, site string, d *APGroup) (*APGroup, error) {
+	var respBody APGroup
+
+	err := c.do(ctx, "PUT", fmt.Sprintf("%s/site/%s/apgroups/%s", c.apiV2Path, site, d.ID), d, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return &respBody, nil
+}
@@ -0,0 +1,13 @@
+package unifi
+
+import (
+	"context"
+	"fmt"
+)
+
+// UpdateDeviceFields updates a device like UpdateDevice, additionally sending the given raw fields
+// keyed by their JSON name. As the fields of Device omit zero values, this allows explicitly sending
+// false and empty values.
+func (c *Client) UpdateDeviceFields(ctx context.Context, site string, d *Device, fields map[string]interface{}) (*Device, error) {
+	return updateFields(ctx, c, fmt.Sprintf("s/%s/rest/device/%s", site, d.ID), d, fields)
+}
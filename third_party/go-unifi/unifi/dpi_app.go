@@ -0,0 +1,33 @@
+package unifi
+
+import (
+	"context"
+	"fmt"
+)
+
+func (c *Client) ListDpiApp(ctx context.Context, site string) ([]DpiApp, error) {
+	return c.listDpiApp(ctx, site)
+}
+
+func (c *Client) GetDpiApp(ctx context.Context, site, id string) (*DpiApp, error) {
+	return c.getDpiApp(ctx, site, id)
+}
+
+func (c *Client) DeleteDpiApp(ctx context.Context, site, id string) error {
+	return c.deleteDpiApp(ctx, site, id)
+}
+
+func (c *Client) CreateDpiApp(ctx context.Context, site string, d *DpiApp) (*DpiApp, error) {
+	return c.createDpiApp(ctx, site, d)
+}
+
+func (c *Client) UpdateDpiApp(ctx context.Context, site string, d *DpiApp) (*DpiApp, error) {
+	return c.updateDpiApp(ctx, site, d)
+}
+
+// UpdateDpiAppFields updates a DPI app like UpdateDpiApp, additionally sending the given raw fields
+// keyed by their JSON name. As the applications and categories omit empty values, this allows
+// clearing them.
+func (c *Client) UpdateDpiAppFields(ctx context.Context, site string, d *DpiApp, fields map[string]interface{}) (*DpiApp, error) {
+	return updateFields(ctx, c, fmt.Sprintf("s/%s/rest/dpiapp/%s", site, d.ID), d, fields)
+}
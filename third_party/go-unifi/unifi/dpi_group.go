@@ -0,0 +1,32 @@
+package unifi
+
+import (
+	"context"
+	"fmt"
+)
+
+func (c *Client) ListDpiGroup(ctx context.Context, site string) ([]DpiGroup, error) {
+	return c.listDpiGroup(ctx, site)
+}
+
+func (c *Client) GetDpiGroup(ctx context.Context, site, id string) (*DpiGroup, error) {
+	return c.getDpiGroup(ctx, site, id)
+}
+
+func (c *Client) DeleteDpiGroup(ctx context.Context, site, id string) error {
+	return c.deleteDpiGroup(ctx, site, id)
+}
+
+func (c *Client) CreateDpiGroup(ctx context.Context, site string, d *DpiGroup) (*DpiGroup, error) {
+	return c.createDpiGroup(ctx, site, d)
+}
+
+func (c *Client) UpdateDpiGroup(ctx context.Context, site string, d *DpiGroup) (*DpiGroup, error) {
+	return c.updateDpiGroup(ctx, site, d)
+}
+
+// UpdateDpiGroupFields updates a DPI group like UpdateDpiGroup, additionally sending the given raw
+// fields keyed by their JSON name. As the application IDs omit empty values, this allows clearing them.
+func (c *Client) UpdateDpiGroupFields(ctx context.Context, site string, d *DpiGroup, fields map[string]interface{}) (*DpiGroup, error) {
+	return updateFields(ctx, c, fmt.Sprintf("s/%s/rest/dpigroup/%s", site, d.ID), d, fields)
+}
@@ -0,0 +1,33 @@
+package unifi
+
+import (
+	"context"
+	"fmt"
+)
+
+func (c *Client) ListHotspotPackage(ctx context.Context, site string) ([]HotspotPackage, error) {
+	return c.listHotspotPackage(ctx, site)
+}
+
+func (c *Client) GetHotspotPackage(ctx context.Context, site, id string) (*HotspotPackage, error) {
+	return c.getHotspotPackage(ctx, site, id)
+}
+
+func (c *Client) DeleteHotspotPackage(ctx context.Context, site, id string) error {
+	return c.deleteHotspotPackage(ctx, site, id)
+}
+
+func (c *Client) CreateHotspotPackage(ctx context.Context, site string, d *HotspotPackage) (*HotspotPackage, error) {
+	return c.createHotspotPackage(ctx, site, d)
+}
+
+func (c *Client) UpdateHotspotPackage(ctx context.Context, site string, d *HotspotPackage) (*HotspotPackage, error) {
+	return c.updateHotspotPackage(ctx, site, d)
+}
+
+// UpdateHotspotPackageFields updates a hotspot package like UpdateHotspotPackage, additionally sending
+// the given raw fields keyed by their JSON name. As the limits and trial settings omit zero values,
+// this allows resetting them.
+func (c *Client) UpdateHotspotPackageFields(ctx context.Context, site string, d *HotspotPackage, fields map[string]interface{}) (*HotspotPackage, error) {
+	return updateFields(ctx, c, fmt.Sprintf("s/%s/rest/hotspotpackage/%s", site, d.ID), d, fields)
+}
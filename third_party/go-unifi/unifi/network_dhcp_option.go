@@ -0,0 +1,54 @@
+package unifi
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// networkDHCPOptionPrefix is the prefix of the keys of a network holding the values of the
+// custom DHCP options of the site.
+const networkDHCPOptionPrefix = "dhcpd_"
+
+// GetNetworkDHCPOptionValues returns the DHCP values of a network keyed by option name. As the
+// keys are not known in advance, the result includes the values of the predefined options.
+func (c *Client) GetNetworkDHCPOptionValues(ctx context.Context, site, id string) (map[string]string, error) {
+	var respBody struct {
+		Meta meta                     `json:"meta"`
+		Data []map[string]interface{} `json:"data"`
+	}
+
+	err := c.do(ctx, "GET", fmt.Sprintf("s/%s/rest/networkconf/%s", site, id), nil, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(respBody.Data) != 1 {
+		return nil, &NotFoundError{}
+	}
+
+	values := map[string]string{}
+	for k, v := range respBody.Data[0] {
+		s, ok := v.(string)
+		if !ok || !strings.HasPrefix(k, networkDHCPOptionPrefix) {
+			continue
+		}
+		values[strings.TrimPrefix(k, networkDHCPOptionPrefix)] = s
+	}
+	return values, nil
+}
+
+// UpdateNetworkDHCPOptionValues sets the values of custom DHCP options of a network, keyed
+// by option name. An empty value removes the option from the network.
+func (c *Client) UpdateNetworkDHCPOptionValues(ctx context.Context, site, id string, values map[string]string) error {
+	reqBody := map[string]string{}
+	for name, v := range values {
+		reqBody[networkDHCPOptionPrefix+name] = v
+	}
+
+	err := c.do(ctx, "PUT", fmt.Sprintf("s/%s/rest/networkconf/%s", site, id), reqBody, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
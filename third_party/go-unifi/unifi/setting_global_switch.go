@@ -0,0 +1,22 @@
+package unifi
+
+import (
+	"context"
+	"fmt"
+)
+
+func (c *Client) GetSettingGlobalSwitch(ctx context.Context, site string) (*SettingGlobalSwitch, error) {
+	return c.getSettingGlobalSwitch(ctx, site)
+}
+
+func (c *Client) UpdateSettingGlobalSwitch(ctx context.Context, site string, d *SettingGlobalSwitch) (*SettingGlobalSwitch, error) {
+	return c.updateSettingGlobalSwitch(ctx, site, d)
+}
+
+// UpdateSettingGlobalSwitchFields updates the settings like UpdateSettingGlobalSwitch, additionally
+// sending the given raw fields keyed by their JSON name. As the switch exclusions omit empty values,
+// this allows clearing them.
+func (c *Client) UpdateSettingGlobalSwitchFields(ctx context.Context, site string, d *SettingGlobalSwitch, fields map[string]interface{}) (*SettingGlobalSwitch, error) {
+	d.Key = "global_switch"
+	return updateFields(ctx, c, fmt.Sprintf("s/%s/set/setting/global_switch", site), d, fields)
+}
@@ -0,0 +1,22 @@
+package unifi
+
+import (
+	"context"
+	"fmt"
+)
+
+func (c *Client) GetSettingGuestAccess(ctx context.Context, site string) (*SettingGuestAccess, error) {
+	return c.getSettingGuestAccess(ctx, site)
+}
+
+func (c *Client) UpdateSettingGuestAccess(ctx context.Context, site string, d *SettingGuestAccess) (*SettingGuestAccess, error) {
+	return c.updateSettingGuestAccess(ctx, site, d)
+}
+
+// UpdateSettingGuestAccessFields updates the settings like UpdateSettingGuestAccess, additionally
+// sending the given raw fields keyed by their JSON name. As the subnets, password and redirect URL
+// omit empty values, this allows clearing them.
+func (c *Client) UpdateSettingGuestAccessFields(ctx context.Context, site string, d *SettingGuestAccess, fields map[string]interface{}) (*SettingGuestAccess, error) {
+	d.Key = "guest_access"
+	return updateFields(ctx, c, fmt.Sprintf("s/%s/set/setting/guest_access", site), d, fields)
+}
@@ -0,0 +1,13 @@
+package unifi
+
+import (
+	"context"
+)
+
+func (c *Client) GetSettingIps(ctx context.Context, site string) (*SettingIps, error) {
+	return c.getSettingIps(ctx, site)
+}
+
+func (c *Client) UpdateSettingIps(ctx context.Context, site string, d *SettingIps) (*SettingIps, error) {
+	return c.updateSettingIps(ctx, site, d)
+}
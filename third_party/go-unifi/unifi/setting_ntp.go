@@ -0,0 +1,13 @@
+package unifi
+
+import (
+	"context"
+)
+
+func (c *Client) GetSettingNtp(ctx context.Context, site string) (*SettingNtp, error) {
+	return c.getSettingNtp(ctx, site)
+}
+
+func (c *Client) UpdateSettingNtp(ctx context.Context, site string, d *SettingNtp) (*SettingNtp, error) {
+	return c.updateSettingNtp(ctx, site, d)
+}
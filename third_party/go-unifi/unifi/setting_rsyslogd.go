@@ -0,0 +1,13 @@
+package unifi
+
+import (
+	"context"
+)
+
+func (c *Client) GetSettingRsyslogd(ctx context.Context, site string) (*SettingRsyslogd, error) {
+	return c.getSettingRsyslogd(ctx, site)
+}
+
+func (c *Client) UpdateSettingRsyslogd(ctx context.Context, site string, d *SettingRsyslogd) (*SettingRsyslogd, error) {
+	return c.updateSettingRsyslogd(ctx, site, d)
+}
@@ -0,0 +1,22 @@
+package unifi
+
+import (
+	"context"
+	"fmt"
+)
+
+func (c *Client) GetSettingSuperMgmt(ctx context.Context, site string) (*SettingSuperMgmt, error) {
+	return c.getSettingSuperMgmt(ctx, site)
+}
+
+func (c *Client) UpdateSettingSuperMgmt(ctx context.Context, site string, d *SettingSuperMgmt) (*SettingSuperMgmt, error) {
+	return c.updateSettingSuperMgmt(ctx, site, d)
+}
+
+// UpdateSettingSuperMgmtFields updates the settings like UpdateSettingSuperMgmt, additionally
+// sending the given raw fields keyed by their JSON name. As the secrets omit empty values, this
+// allows clearing them.
+func (c *Client) UpdateSettingSuperMgmtFields(ctx context.Context, site string, d *SettingSuperMgmt, fields map[string]interface{}) (*SettingSuperMgmt, error) {
+	d.Key = "super_mgmt"
+	return updateFields(ctx, c, fmt.Sprintf("s/%s/set/setting/super_mgmt", site), d, fields)
+}
@@ -0,0 +1,496 @@
+package unifi //
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	apiPath   = "/api"
+	apiV2Path = "/v2/api"
+
+	apiPathNew   = "/proxy/network/api"
+	apiV2PathNew = "/proxy/network/v2/api"
+
+	loginPath    = "/api/login"
+	loginPathNew = "/api/auth/login"
+
+	statusPath    = "/status"
+	statusPathNew = "/proxy/network/status"
+)
+
+type NotFoundError struct{}
+
+func (err *NotFoundError) Error() string {
+	return "not found"
+}
+
+type APIError struct {
+	RC      string
+	Message string
+}
+
+func (err *APIError) Error() string {
+	return err.Message
+}
+
+type Client struct {
+	// guards the CSRF token, which may be refreshed by any of the concurrent requests
+	sync.Mutex
+
+	c       *http.Client
+	baseURL *url.URL
+
+	apiPath    string
+	apiV2Path  string
+	loginPath  string
+	statusPath string
+
+	csrf   string
+	apiKey string
+
+	// credentials are kept to transparently log in again when the session expires
+	user string
+	pass string
+
+	maxRetries   int
+	retryBackoff time.Duration
+
+	// serializes re-authentication, session is bumped on every successful re-login so
+	// requests failing with an already replaced session do not log in again
+	loginMu sync.Mutex
+	session atomic.Int64
+
+	version string
+}
+
+// maxRetryBackoff caps the exponential backoff between retries.
+const maxRetryBackoff = 30 * time.Second
+
+// SetRetryPolicy configures how many times idempotent requests are retried on transient
+// errors (gateway errors and transport failures), and the initial backoff between attempts,
+// which doubles on each retry.
+func (c *Client) SetRetryPolicy(maxRetries int, backoff time.Duration) {
+	c.maxRetries = maxRetries
+	c.retryBackoff = backoff
+}
+
+func (c *Client) CSRFToken() string {
+	c.Lock()
+	defer c.Unlock()
+	return c.csrf
+}
+
+func (c *Client) setCSRFToken(csrf string) {
+	c.Lock()
+	defer c.Unlock()
+	c.csrf = csrf
+}
+
+func (c *Client) Version() string {
+	return c.version
+}
+
+func (c *Client) SetBaseURL(base string) error {
+	var err error
+	c.baseURL, err = url.Parse(base)
+	if err != nil {
+		return err
+	}
+
+	// error for people who are still passing hard coded old paths
+	if path := strings.TrimSuffix(c.baseURL.Path, "/"); path == apiPath {
+		return fmt.Errorf("expected a base URL without the `/api`, got: %q", c.baseURL)
+	}
+
+	return nil
+}
+
+func (c *Client) SetHTTPClient(hc *http.Client) error {
+	c.c = hc
+	return nil
+}
+
+func (c *Client) setAPIUrlStyle(ctx context.Context) error {
+	// check if new style API
+	// this is modified from the unifi-poller (https://github.com/unifi-poller/unifi) implementation.
+	// see https://github.com/unifi-poller/unifi/blob/4dc44f11f61a2e08bf7ec5b20c71d5bced837b5d/unifi.go#L101-L104
+	// and https://github.com/unifi-poller/unifi/commit/43a6b225031a28f2b358f52d03a7217c7b524143
+
+	req, err := http.NewRequestWithContext(ctx, "GET", c.baseURL.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	// We can't share these cookies with other requests, so make a new client.
+	// Checking the return code on the first request so don't follow a redirect.
+	client := &http.Client{
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+		Transport: c.c.Transport,
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode == http.StatusOK {
+		// the new API returns a 200 for a / request
+		c.apiPath = apiPathNew
+		c.apiV2Path = apiV2PathNew
+		c.loginPath = loginPathNew
+		c.statusPath = statusPathNew
+		return nil
+	}
+
+	// The old version returns a "302" (to /manage) for a / request
+	c.apiPath = apiPath
+	c.apiV2Path = apiV2Path
+	c.loginPath = loginPath
+	c.statusPath = statusPath
+	return nil
+}
+
+func (c *Client) Login(ctx context.Context, user, pass string) error {
+	if c.c == nil {
+		c.c = &http.Client{}
+
+		jar, _ := cookiejar.New(nil)
+		c.c.Jar = jar
+	}
+
+	err := c.setAPIUrlStyle(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to determine API URL style: %w", err)
+	}
+
+	c.user = user
+	c.pass = pass
+
+	err = c.do(ctx, "POST", c.loginPath, &struct {
+		Username string `json:"username"`
+		Password string `json:"password"`
+	}{
+		Username: user,
+		Password: pass,
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	return c.setVersion(ctx)
+}
+
+// LoginWithAPIKey authenticates every request with the X-API-KEY header instead of a
+// session cookie. API keys are only supported by UniFi OS consoles.
+func (c *Client) LoginWithAPIKey(ctx context.Context, apiKey string) error {
+	if c.c == nil {
+		c.c = &http.Client{}
+	}
+
+	err := c.setAPIUrlStyle(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to determine API URL style: %w", err)
+	}
+
+	if c.apiPath != apiPathNew {
+		return fmt.Errorf("API key authentication requires a UniFi OS console")
+	}
+
+	c.apiKey = apiKey
+
+	return c.setVersion(ctx)
+}
+
+func (c *Client) setVersion(ctx context.Context) error {
+	var status struct {
+		Meta struct {
+			ServerVersion string `json:"server_version"`
+			UUID          string `json:"uuid"`
+		} `json:"meta"`
+	}
+
+	err := c.do(ctx, "GET", c.statusPath, nil, &status)
+	if err != nil {
+		return err
+	}
+
+	if version := status.Meta.ServerVersion; version != "" {
+		c.version = status.Meta.ServerVersion
+		return nil
+	}
+
+	// newer version of 6.0 controller, use sysinfo to determine version
+	// using default site since it must exist
+	si, err := c.sysinfo(ctx, "default")
+	if err != nil {
+		return err
+	}
+
+	c.version = si.Version
+
+	if c.version == "" {
+		return fmt.Errorf("unable to determine controller version")
+	}
+
+	return nil
+}
+
+func (c *Client) do(ctx context.Context, method, relativeURL string, reqBody interface{}, respBody interface{}) error {
+	var (
+		err      error
+		reqBytes []byte
+	)
+	if reqBody != nil {
+		reqBytes, err = json.Marshal(reqBody)
+		if err != nil {
+			return fmt.Errorf("unable to marshal JSON: %s %s %w", method, relativeURL, err)
+		}
+	}
+
+	// building the request only fails on a malformed URL or method, which no retry can fix
+	req, err := c.newRequest(ctx, method, relativeURL, reqBytes)
+	if err != nil {
+		return err
+	}
+
+	relogged := false
+	for retries := 0; ; {
+		var status int
+		session := c.session.Load()
+		status, err = c.doOnce(req, relativeURL, respBody)
+		if err == nil {
+			return nil
+		}
+
+		switch {
+		case !relogged && c.canRelogin(status, relativeURL):
+			// the session has most likely expired, log in again and replay the request once
+			relogged = true
+			if loginErr := c.relogin(ctx, session); loginErr != nil {
+				return fmt.Errorf("unable to re-authenticate after %w: %w", err, loginErr)
+			}
+		case retries < c.maxRetries && c.isRetryable(ctx, method, status):
+			if waitErr := c.waitForRetry(ctx, retries); waitErr != nil {
+				return err
+			}
+			retries++
+		default:
+			return err
+		}
+	}
+}
+
+func (c *Client) canRelogin(status int, relativeURL string) bool {
+	if status != http.StatusUnauthorized && status != http.StatusForbidden {
+		return false
+	}
+	// API keys do not expire like sessions, and a failed login should not be retried with itself
+	return c.user != "" && c.apiKey == "" && relativeURL != c.loginPath
+}
+
+func (c *Client) relogin(ctx context.Context, session int64) error {
+	c.loginMu.Lock()
+	defer c.loginMu.Unlock()
+
+	if c.session.Load() != session {
+		// another request already logged in again while this one was in flight
+		return nil
+	}
+
+	loginBytes, err := json.Marshal(&struct {
+		Username string `json:"username"`
+		Password string `json:"password"`
+	}{
+		Username: c.user,
+		Password: c.pass,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := c.newRequest(ctx, "POST", c.loginPath, loginBytes)
+	if err != nil {
+		return err
+	}
+
+	c.setCSRFToken("")
+	_, err = c.doOnce(req, c.loginPath, nil)
+	if err != nil {
+		return err
+	}
+
+	c.session.Add(1)
+	return nil
+}
+
+// isRetryable reports whether a failed request can safely be sent again, which is
+// limited to idempotent methods failing with a gateway error or a transport error.
+func (c *Client) isRetryable(ctx context.Context, method string, status int) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+	default:
+		return false
+	}
+
+	switch status {
+	case 0:
+		return ctx.Err() == nil
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+
+	return false
+}
+
+func (c *Client) waitForRetry(ctx context.Context, retries int) error {
+	backoff := c.retryBackoff << retries
+	// the shift can overflow for large retry counts
+	if backoff > maxRetryBackoff || backoff < c.retryBackoff {
+		backoff = maxRetryBackoff
+	}
+
+	timer := time.NewTimer(backoff)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// newRequest resolves relativeURL against the API path and builds the request sent by
+// each attempt in do.
+func (c *Client) newRequest(ctx context.Context, method, relativeURL string, reqBytes []byte) (*http.Request, error) {
+	var reqReader io.Reader
+	if reqBytes != nil {
+		reqReader = bytes.NewReader(reqBytes)
+	}
+
+	reqURL, err := url.Parse(relativeURL)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse URL: %s %s %w", method, relativeURL, err)
+	}
+	if !strings.HasPrefix(relativeURL, "/") && !reqURL.IsAbs() {
+		reqURL.Path = path.Join(c.apiPath, reqURL.Path)
+	}
+
+	url := c.baseURL.ResolveReference(reqURL)
+	req, err := http.NewRequestWithContext(ctx, method, url.String(), reqReader)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s %s %w", method, relativeURL, err)
+	}
+
+	req.Header.Set("User-Agent", "terraform-provider-unifi/0.1")
+	req.Header.Add("Content-Type", "application/json; charset=utf-8")
+
+	if c.apiKey != "" {
+		req.Header.Set("X-API-KEY", c.apiKey)
+	}
+
+	return req, nil
+}
+
+// doOnce performs a single attempt of base, returning the HTTP status code (or 0 if no
+// response was received) along with any error.
+func (c *Client) doOnce(base *http.Request, relativeURL string, respBody interface{}) (int, error) {
+	ctx := base.Context()
+	method := base.Method
+	url := base.URL
+
+	// every attempt needs its own headers and an unread body
+	req := base.Clone(ctx)
+	if base.GetBody != nil {
+		body, err := base.GetBody()
+		if err != nil {
+			return 0, fmt.Errorf("unable to create request: %s %s %w", method, relativeURL, err)
+		}
+		req.Body = body
+	}
+
+	if csrf := c.CSRFToken(); csrf != "" {
+		req.Header.Set("X-CSRF-Token", csrf)
+	}
+
+	resp, err := c.c.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("unable to perform request: %s %s %w", method, relativeURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return resp.StatusCode, &NotFoundError{}
+	}
+
+	if csrf := resp.Header.Get("x-csrf-token"); csrf != "" {
+		c.setCSRFToken(csrf)
+	}
+
+	if resp.StatusCode != 200 {
+		errBody := struct {
+			Meta meta `json:"meta"`
+			Data []struct {
+				Meta meta `json:"meta"`
+			} `json:"data"`
+		}{}
+		if err = json.NewDecoder(resp.Body).Decode(&errBody); err != nil {
+			return resp.StatusCode, fmt.Errorf("unable to decode error body (%s) for %s %s: %w", resp.Status, method, url.String(), err)
+		}
+		var apiErr error
+		if len(errBody.Data) > 0 && errBody.Data[0].Meta.RC == "error" {
+			// check first error in data, should we look for more than one?
+			apiErr = errBody.Data[0].Meta.error()
+		}
+		if apiErr == nil {
+			apiErr = errBody.Meta.error()
+		}
+		return resp.StatusCode, fmt.Errorf("%w (%s) for %s %s", apiErr, resp.Status, method, url.String())
+	}
+
+	if respBody == nil || resp.ContentLength == 0 {
+		return resp.StatusCode, nil
+	}
+
+	// TODO: check rc in addition to status code?
+
+	err = json.NewDecoder(resp.Body).Decode(respBody)
+	if err != nil {
+		return resp.StatusCode, fmt.Errorf("unable to decode body: %s %s %w", method, relativeURL, err)
+	}
+
+	return resp.StatusCode, nil
+}
+
+type meta struct {
+	RC      string `json:"rc"`
+	Message string `json:"msg"`
+}
+
+func (m *meta) error() error {
+	if m.RC != "ok" {
+		return &APIError{
+			RC:      m.RC,
+			Message: m.Message,
+		}
+	}
+
+	return nil
+}
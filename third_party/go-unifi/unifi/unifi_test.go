@@ -0,0 +1,130 @@
+package unifi_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/paultyng/go-unifi/unifi"
+)
+
+func TestLoginWithAPIKey(t *testing.T) {
+	const apiKey = "test-api-key"
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/":
+			w.WriteHeader(http.StatusOK)
+		case "/proxy/network/status":
+			if got := r.Header.Get("X-API-KEY"); got != apiKey {
+				t.Errorf("expected X-API-KEY %q, got %q", apiKey, got)
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"meta":{"rc":"ok","server_version":"7.4.162"}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := &unifi.Client{}
+	if err := c.SetBaseURL(srv.URL); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.LoginWithAPIKey(context.Background(), apiKey); err != nil {
+		t.Fatal(err)
+	}
+	if v := c.Version(); v != "7.4.162" {
+		t.Fatalf("expected version 7.4.162, got %q", v)
+	}
+}
+
+func TestLoginWithAPIKey_classicController(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		// classic controllers redirect / to /manage
+		http.Redirect(w, r, "/manage", http.StatusFound)
+	}))
+	defer srv.Close()
+
+	c := &unifi.Client{}
+	if err := c.SetBaseURL(srv.URL); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.LoginWithAPIKey(context.Background(), "test-api-key"); err == nil {
+		t.Fatal("expected an error for a controller without UniFi OS")
+	}
+}
+
+func TestMalformedURLIsNotRetried(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	}))
+	defer srv.Close()
+
+	c := &unifi.Client{}
+	if err := c.SetBaseURL(srv.URL); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.SetHTTPClient(srv.Client()); err != nil {
+		t.Fatal(err)
+	}
+	// a retry would wait for the backoff first
+	c.SetRetryPolicy(3, time.Hour)
+
+	start := time.Now()
+	_, err := c.GetNetwork(context.Background(), "%zz", "id")
+	if err == nil {
+		t.Fatal("expected an error for a malformed URL")
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Fatalf("expected no retries, took %s", elapsed)
+	}
+}
+
+func TestUpdateDeviceFieldsSendsRawFields(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" || r.URL.Path != "/s/default/rest/device/id" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		body := map[string]interface{}{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		if v, ok := body["name"]; !ok || v != "switch" {
+			t.Errorf("expected name switch, got %v", v)
+		}
+		if v, ok := body["snmp_contact"]; !ok || v != "" {
+			t.Errorf("expected empty snmp_contact to be sent, got %v", v)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[{"_id":"id","name":"switch"}]}`))
+	}))
+	defer srv.Close()
+
+	c := &unifi.Client{}
+	if err := c.SetBaseURL(srv.URL); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.SetHTTPClient(srv.Client()); err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := c.UpdateDeviceFields(context.Background(), "default", &unifi.Device{ID: "id", Name: "switch"}, map[string]interface{}{
+		"snmp_contact": "",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Name != "switch" {
+		t.Fatalf("expected name switch, got %q", d.Name)
+	}
+}
@@ -0,0 +1,43 @@
+package unifi
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// updateFields PUTs v to the relative URL, additionally sending the given raw fields keyed by their
+// JSON name. As most generated fields omit zero values, this allows explicitly sending false and
+// empty values.
+func updateFields[T any](ctx context.Context, c *Client, relativeURL string, v *T, fields map[string]interface{}) (*T, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	reqBody := map[string]interface{}{}
+	err = json.Unmarshal(b, &reqBody)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range fields {
+		reqBody[k] = v
+	}
+
+	var respBody struct {
+		Meta meta `json:"meta"`
+		Data []T  `json:"data"`
+	}
+
+	err = c.do(ctx, "PUT", relativeURL, reqBody, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(respBody.Data) != 1 {
+		return nil, &NotFoundError{}
+	}
+
+	new := respBody.Data[0]
+
+	return &new, nil
+}
@@ -0,0 +1,5 @@
+// Generated code. DO NOT EDIT.
+
+package unifi
+
+const UnifiVersion = "7.3.83"
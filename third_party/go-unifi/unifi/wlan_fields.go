@@ -0,0 +1,13 @@
+package unifi
+
+import (
+	"context"
+	"fmt"
+)
+
+// UpdateWLANFields updates a WLAN like UpdateWLAN, additionally sending the given raw fields
+// keyed by their JSON name. As many fields of WLAN omit zero values, this allows explicitly
+// sending empty lists and values.
+func (c *Client) UpdateWLANFields(ctx context.Context, site string, d *WLAN, fields map[string]interface{}) (*WLAN, error) {
+	return updateFields(ctx, c, fmt.Sprintf("s/%s/rest/wlanconf/%s", site, d.ID), d, fields)
+}